@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AccountDataSource{}
+
+func NewAccountDataSource() datasource.DataSource {
+	return &AccountDataSource{}
+}
+
+// AccountDataSource defines the data source implementation.
+type AccountDataSource struct {
+	client *ICSClient
+}
+
+// AccountDataSourceModel describes the data source data model.
+type AccountDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	AccountID    types.Int64  `tfsdk:"account_id"`
+	Name         types.String `tfsdk:"name"`
+	Email        types.String `tfsdk:"email"`
+	Balance      types.Number `tfsdk:"balance"`
+	CurrencyCode types.String `tfsdk:"currency_code"`
+	Permissions  types.List   `tfsdk:"permissions"`
+}
+
+func (d *AccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *AccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Account data source provides details of the account the provider's API token belongs to.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier (the account ID)",
+				Computed:            true,
+			},
+			"account_id": schema.Int64Attribute{
+				MarkdownDescription: "Account identifier",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Account name",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Account contact email address",
+				Computed:            true,
+			},
+			"balance": schema.NumberAttribute{
+				MarkdownDescription: "Account balance. A negative balance is an amount owed.",
+				Computed:            true,
+			},
+			"currency_code": schema.StringAttribute{
+				MarkdownDescription: "Currency of the balance (e.g., 'USD')",
+				Computed:            true,
+			},
+			"permissions": schema.ListAttribute{
+				MarkdownDescription: "Permissions granted to the API token (e.g., 'servers:write')",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get account from API
+	account, err := d.client.GetAccount(ctx)
+	if errors.Is(err, ErrUnauthorized) {
+		resp.Diagnostics.AddError("Invalid API Token", "The API token was rejected by the ICS API. Check that the token is correct and has not been revoked.")
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account, got error: %s", err))
+		return
+	}
+
+	// Convert API response to Terraform model
+	permissions := []attr.Value{}
+	for _, permission := range account.Permissions {
+		permissions = append(permissions, types.StringValue(permission))
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(account.ID))
+	data.AccountID = types.Int64Value(int64(account.ID))
+	data.Name = types.StringValue(account.Name)
+	data.Email = types.StringValue(account.Email)
+	data.Balance = types.NumberValue(account.Balance.Amount)
+	data.CurrencyCode = types.StringValue(account.CurrencyCode)
+	data.Permissions = types.ListValueMust(types.StringType, permissions)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
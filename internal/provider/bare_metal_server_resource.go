@@ -2,23 +2,43 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"net"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// maxUserDataSize is the largest decoded user data payload accepted with a server order.
+const maxUserDataSize = 64 * 1024
+
+// defaultReadinessTimeout is how long to wait for wait_for_ssh / wait_for_port when
+// readiness_timeout is not set.
+const defaultReadinessTimeout = 15 * time.Minute
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BareMetalServerResource{}
 var _ resource.ResourceWithImportState = &BareMetalServerResource{}
+var _ resource.ResourceWithValidateConfig = &BareMetalServerResource{}
+var _ resource.ResourceWithModifyPlan = &BareMetalServerResource{}
 
 func NewBareMetalServerResource() resource.Resource {
 	return &BareMetalServerResource{}
@@ -38,10 +58,21 @@ type BareMetalServerResourceModel struct {
 	Hostname           types.String `tfsdk:"hostname"`
 	FriendlyName       types.String `tfsdk:"friendly_name"`
 	SSHKeyLabels       types.List   `tfsdk:"ssh_key_labels"`
+	UserData           types.String `tfsdk:"user_data"`        // Write-only, never persisted
+	UserDataBase64     types.String `tfsdk:"user_data_base64"` // Write-only, never persisted
+	WaitForSSH         types.Bool   `tfsdk:"wait_for_ssh"`
+	WaitForPort        types.Int64  `tfsdk:"wait_for_port"`
+	ReadinessTimeout   types.String `tfsdk:"readiness_timeout"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	StoreRootPassword  types.Bool   `tfsdk:"store_root_password"`
+	Tags               types.Map    `tfsdk:"tags"`
 
 	// Computed/output fields
 	ServiceID          types.Int64  `tfsdk:"service_id"`
 	PublicIP           types.String `tfsdk:"public_ip"`
+	IPAddresses        types.List   `tfsdk:"ip_addresses"`
+	IPv6Addresses      types.List   `tfsdk:"ipv6_addresses"`
+	PrivateIPAddresses types.List   `tfsdk:"private_ip_addresses"`
 	RootPassword       types.String `tfsdk:"root_password"`
 	ServiceDescription types.String `tfsdk:"service_description"`
 	PlanID             types.Int64  `tfsdk:"plan_id"`
@@ -49,6 +80,8 @@ type BareMetalServerResourceModel struct {
 	DatacenterID       types.Int64  `tfsdk:"datacenter_id"`
 	LocationID         types.Int64  `tfsdk:"location_id"`
 	ServerTypeInternal types.String `tfsdk:"server_type"` // Keep for internal use
+	UserDataHash       types.String `tfsdk:"user_data_hash"`
+	TagsAll            types.Map    `tfsdk:"tags_all"`
 }
 
 func (r *BareMetalServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,36 +105,94 @@ func (r *BareMetalServerResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "Instance type (e.g., 'c1.small', 'c1.medium'). The provider will automatically validate availability and inventory.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessImported(),
 				},
 			},
 			"location": schema.StringAttribute{
-				MarkdownDescription: "Location code (e.g., 'NYC1', 'FRA1'). The provider will automatically validate inventory availability for this location.",
+				MarkdownDescription: "Location code (e.g., 'NYC1', 'FRA1'). Validated during plan against the `ics_locations` data source; inventory availability is checked when ordering.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessImported(),
 				},
 			},
 			"operating_system": schema.StringAttribute{
 				MarkdownDescription: "Operating system name (e.g., 'Ubuntu 24.04', 'Debian 12', 'CentOS 8'). The provider will automatically validate availability for the specified instance type and location.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessImported(),
 				},
 			},
 			"hostname": schema.StringAttribute{
-				MarkdownDescription: "Hostname for the server",
+				MarkdownDescription: "Hostname for the server. Can be updated in-place; if omitted, the hostname assigned by ICS is used.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"friendly_name": schema.StringAttribute{
-				MarkdownDescription: "Friendly name for the server",
+				MarkdownDescription: "Friendly name for the server. Can be updated in-place.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"ssh_key_labels": schema.ListAttribute{
-				MarkdownDescription: "List of SSH key labels to add to the server. The SSH keys must already exist.",
+				MarkdownDescription: "List of SSH key labels to add to the server. The SSH keys must already exist. Can be updated in-place; keys are added to or removed from the running server. Do not combine with `ics_server_ssh_key_attachment` resources for the same server.",
 				ElementType:         types.StringType,
 				Optional:            true,
 			},
+			"user_data": schema.StringAttribute{
+				MarkdownDescription: "Cloud-init user data passed to the server at order time. Only applied by operating system images that support cloud-init. Limited to 64 KiB. This is a write-only attribute (requires Terraform 1.11 or later) and is never stored in state; only its SHA-256 hash is kept in `user_data_hash`. Changing it forces replacement of the server. Conflicts with `user_data_base64`.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"user_data_base64": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded cloud-init user data, for binary or gzip-compressed payloads. Limited to 64 KiB once decoded. Write-only like `user_data`, which it conflicts with.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"wait_for_ssh": schema.BoolAttribute{
+				MarkdownDescription: "Wait after provisioning until the server accepts TCP connections on port 22",
+				Optional:            true,
+			},
+			"wait_for_port": schema.Int64Attribute{
+				MarkdownDescription: "Wait after provisioning until the server accepts TCP connections on this port. Takes precedence over `wait_for_ssh`.",
+				Optional:            true,
+			},
+			"readiness_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for `wait_for_ssh` or `wait_for_port` as a Go duration (e.g., '10m'). Defaults to '15m'.",
+				Optional:            true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevent the server from being cancelled, including when a change forces replacement. Must be set to false and applied before the server can be destroyed. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"store_root_password": schema.BoolAttribute{
+				MarkdownDescription: "Whether to persist the root password in Terraform state as `root_password`. Set to false and use the `ics_server_root_password` ephemeral resource to keep credentials out of state. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Tags to assign to the server. Merged with the provider's `default_tags`, taking precedence for matching keys. Can be updated in-place.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"tags_all": schema.MapAttribute{
+				MarkdownDescription: "All tags assigned to the server, including those inherited from the provider's `default_tags`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"user_data_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the decoded user data sent with the order",
+				Computed:            true,
+			},
 			"service_id": schema.Int64Attribute{
 				MarkdownDescription: "Service identifier",
 				Computed:            true,
@@ -110,7 +201,7 @@ func (r *BareMetalServerResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"root_password": schema.StringAttribute{
-				MarkdownDescription: "Root password for the server",
+				MarkdownDescription: "Root password for the server. Null when `store_root_password` is false.",
 				Computed:            true,
 				Sensitive:           true,
 			},
@@ -118,6 +209,21 @@ func (r *BareMetalServerResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "Public IP address",
 				Computed:            true,
 			},
+			"ip_addresses": schema.ListAttribute{
+				MarkdownDescription: "All IP addresses routed to the server, including the primary public IP, additional IPv4 addresses and IPv6 addresses",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"ipv6_addresses": schema.ListAttribute{
+				MarkdownDescription: "IPv6 addresses routed to the server",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"private_ip_addresses": schema.ListAttribute{
+				MarkdownDescription: "Addresses of the server on attached private networks",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 			"service_description": schema.StringAttribute{
 				MarkdownDescription: "Service description",
 				Computed:            true,
@@ -166,6 +272,186 @@ func (r *BareMetalServerResource) Configure(ctx context.Context, req resource.Co
 	r.client = client
 }
 
+func (r *BareMetalServerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var waitForPort types.Int64
+	var readinessTimeout types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("wait_for_port"), &waitForPort)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("readiness_timeout"), &readinessTimeout)...)
+
+	if !waitForPort.IsNull() && !waitForPort.IsUnknown() {
+		if port := waitForPort.ValueInt64(); port < 1 || port > 65535 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_for_port"),
+				"Invalid Port",
+				fmt.Sprintf("wait_for_port must be between 1 and 65535, got %d.", port),
+			)
+		}
+	}
+
+	if !readinessTimeout.IsNull() && !readinessTimeout.IsUnknown() {
+		if _, err := time.ParseDuration(readinessTimeout.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("readiness_timeout"),
+				"Invalid Readiness Timeout",
+				fmt.Sprintf("readiness_timeout must be a duration such as '10m' or '1h': %s", err),
+			)
+		}
+	}
+
+	var userData, userDataBase64 types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("user_data"), &userData)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("user_data_base64"), &userDataBase64)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !userData.IsNull() && !userDataBase64.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_data_base64"),
+			"Conflicting User Data",
+			"Only one of user_data or user_data_base64 can be set.",
+		)
+		return
+	}
+
+	content, diags := userDataFromConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || content.IsNull() || content.IsUnknown() {
+		return
+	}
+
+	// Report the size against whichever attribute was set
+	contentPath := path.Root("user_data")
+	if !userDataBase64.IsNull() {
+		contentPath = path.Root("user_data_base64")
+	}
+
+	if len(content.ValueString()) > maxUserDataSize {
+		resp.Diagnostics.AddAttributeError(
+			contentPath,
+			"User Data Too Large",
+			fmt.Sprintf("User data is %d bytes, which exceeds the maximum of %d bytes.", len(content.ValueString()), maxUserDataSize),
+		)
+	}
+}
+
+func (r *BareMetalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Write-only user data never reaches the plan, so track changes through its hash
+	userData, diags := userDataFromConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plannedHash := types.StringNull()
+	if userData.IsUnknown() {
+		plannedHash = types.StringUnknown()
+	} else if !userData.IsNull() {
+		plannedHash = types.StringValue(userDataHash(userData.ValueString()))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("user_data_hash"), plannedHash)...)
+
+	// Merge provider default tags into tags_all
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+
+	tagsAll, diags := r.allTags(tags)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Catch misspelled location codes at plan time instead of after ordering
+	var plannedLocation, priorLocation types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("location"), &plannedLocation)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("location"), &priorLocation)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plannedLocation.IsUnknown() && !plannedLocation.IsNull() && !plannedLocation.Equal(priorLocation) {
+		r.validateLocation(ctx, plannedLocation.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state BareMetalServerResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Attribute-level RequiresReplace modifiers are not visible here, so check those attributes directly
+	var replacedBy []string
+	if !state.InstanceType.IsNull() && !plan.InstanceType.Equal(state.InstanceType) {
+		replacedBy = append(replacedBy, "instance_type")
+	}
+	if !state.Location.IsNull() && !plan.Location.Equal(state.Location) {
+		replacedBy = append(replacedBy, "location")
+	}
+	if !state.OperatingSystem.IsNull() && !plan.OperatingSystem.Equal(state.OperatingSystem) {
+		replacedBy = append(replacedBy, "operating_system")
+	}
+
+	if !state.UserDataHash.IsNull() && !state.UserDataHash.Equal(plannedHash) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("user_data_hash"))
+		replacedBy = append(replacedBy, "user_data")
+	} else if state.UserDataHash.IsNull() && !plannedHash.IsNull() && len(replacedBy) == 0 {
+		// Servers ordered without user data (imported, or created before user_data
+		// was supported) are adopted without replacement. User data is only sent
+		// with an order, so the hash stays null until the server is next ordered.
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("user_data_hash"), types.StringNull())...)
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("user_data"),
+			"User Data Not Applied",
+			fmt.Sprintf("Server %s was ordered without user data. User data is only applied when a server is ordered, so it will not reach the existing server; it will be applied the next time the server is replaced.", state.ID.ValueString()),
+		)
+	}
+
+	// Replacement cancels the existing server, which Delete refuses to do while
+	// deletion protection is on, so fail at plan time rather than mid-apply
+	if state.DeletionProtection.ValueBool() && (len(replacedBy) > 0 || len(resp.RequiresReplace) > 0) {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Server %s (service ID %d) has deletion_protection enabled, but changes to %s require it to be cancelled and replaced. Set deletion_protection = false and apply before making this change.", state.ID.ValueString(), state.ServiceID.ValueInt64(), strings.Join(replacedBy, ", ")),
+		)
+		return
+	}
+
+	if r.client == nil || !r.client.WarnOnServerReplacement {
+		return
+	}
+
+	if len(replacedBy) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Bare Metal Server Will Be Replaced",
+		fmt.Sprintf("Server %s (service ID %d, public IP %s) will be CANCELLED and a new server ordered because of changes to: %s.\n\nThe existing hardware and its data will be lost and may not be re-orderable.", state.ID.ValueString(), state.ServiceID.ValueInt64(), state.PublicIP.ValueString(), strings.Join(replacedBy, ", ")),
+	)
+}
+
 func (r *BareMetalServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data BareMetalServerResourceModel
 
@@ -176,6 +462,22 @@ func (r *BareMetalServerResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// readiness_timeout may have been unknown during validation, so check it
+	// again before ordering a server that could not be waited on
+	timeout := defaultReadinessTimeout
+	if !data.ReadinessTimeout.IsNull() {
+		parsed, err := time.ParseDuration(data.ReadinessTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("readiness_timeout"),
+				"Invalid Readiness Timeout",
+				fmt.Sprintf("readiness_timeout must be a duration such as '10m' or '1h': %s", err),
+			)
+			return
+		}
+		timeout = parsed
+	}
+
 	instanceType := data.InstanceType.ValueString()
 	location := data.Location.ValueString()
 	osName := data.OperatingSystem.ValueString()
@@ -186,10 +488,10 @@ func (r *BareMetalServerResource) Create(ctx context.Context, req resource.Creat
 		"location":      location,
 	})
 
-	sku, err := r.client.FindSKUByProductName(instanceType, location)
+	sku, err := r.client.FindSKUByProductName(ctx, instanceType, location)
 	if err != nil {
 		// Provide helpful error messages with suggestions
-		inventory, invErr := r.client.GetInventory()
+		inventory, invErr := r.client.GetInventory(ctx)
 		if invErr != nil {
 			resp.Diagnostics.AddError(
 				"Instance Type or Location Invalid",
@@ -246,7 +548,7 @@ func (r *BareMetalServerResource) Create(ctx context.Context, req resource.Creat
 		"location":      location,
 	})
 
-	addons, osErr := r.client.GetAddons(instanceType, location)
+	addons, osErr := r.client.GetAddons(ctx, instanceType, location)
 	if osErr != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Retrieve Operating System Options",
@@ -292,7 +594,7 @@ func (r *BareMetalServerResource) Create(ctx context.Context, req resource.Creat
 		BillHourly:                 true,           // Always bill hourly
 	}
 
-	if !data.Hostname.IsNull() {
+	if !data.Hostname.IsNull() && !data.Hostname.IsUnknown() {
 		orderReq.Hostname = data.Hostname.ValueString()
 	}
 
@@ -306,7 +608,7 @@ func (r *BareMetalServerResource) Create(ctx context.Context, req resource.Creat
 
 		var sshKeyIDs []int
 		for _, label := range sshKeyLabels {
-			sshKey, err := r.client.GetSSHKeyByLabel(label)
+			sshKey, err := r.client.GetSSHKeyByLabel(ctx, label)
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"SSH Key Not Found",
@@ -326,8 +628,24 @@ func (r *BareMetalServerResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
+	// Write-only user data is only available from the configuration
+	userData, diags := userDataFromConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.UserDataHash = types.StringNull()
+	if !userData.IsNull() {
+		orderReq.UserData = base64.StdEncoding.EncodeToString([]byte(userData.ValueString()))
+		data.UserDataHash = types.StringValue(userDataHash(userData.ValueString()))
+		tflog.Info(ctx, "Adding user data to server order", map[string]interface{}{
+			"user_data_hash": data.UserDataHash.ValueString(),
+		})
+	}
+
 	// Order the server
-	orderResp, err := r.client.OrderServer(orderReq)
+	orderResp, err := r.client.OrderServer(ctx, orderReq)
 
 	if err != nil {
 		// Check if this is a timeout error after a potentially successful order
@@ -368,14 +686,14 @@ func (r *BareMetalServerResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	// Update friendly name if specified (post-provision)
-	if !data.FriendlyName.IsNull() {
+	if !data.FriendlyName.IsNull() && !data.FriendlyName.IsUnknown() {
 		friendlyName := data.FriendlyName.ValueString()
 		tflog.Info(ctx, "Setting server friendly name", map[string]interface{}{
 			"server_id":     server.ID,
 			"friendly_name": friendlyName,
 		})
 
-		err = r.client.UpdateServerFriendlyName(server.ID, friendlyName)
+		err = r.client.UpdateServerFriendlyName(ctx, server.ID, friendlyName)
 		if err != nil {
 			resp.Diagnostics.AddWarning(
 				"Friendly Name Update Failed",
@@ -384,6 +702,28 @@ func (r *BareMetalServerResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
+	// Apply tags if specified (post-provision)
+	if len(data.TagsAll.Elements()) > 0 {
+		var tagsAll map[string]string
+		resp.Diagnostics.Append(data.TagsAll.ElementsAs(ctx, &tagsAll, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Info(ctx, "Setting server tags", map[string]interface{}{
+			"server_id": server.ID,
+			"tags":      tagsAll,
+		})
+
+		err = r.client.SetServerTags(ctx, server.ID, tagsAll)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Tags Update Failed",
+				fmt.Sprintf("Server was provisioned successfully but failed to set tags: %s", err),
+			)
+		}
+	}
+
 	// Update the model with server details
 	r.updateModelFromServer(&data, server)
 
@@ -395,6 +735,32 @@ func (r *BareMetalServerResource) Create(ctx context.Context, req resource.Creat
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Optionally wait for the operating system to come up. The server is already
+	// in state, so a failure here taints it rather than orphaning it.
+	port := 0
+	if !data.WaitForPort.IsNull() {
+		port = int(data.WaitForPort.ValueInt64())
+	} else if data.WaitForSSH.ValueBool() {
+		port = 22
+	}
+
+	if port == 0 {
+		return
+	}
+
+	tflog.Info(ctx, "Waiting for server to accept connections", map[string]interface{}{
+		"public_ip": server.PublicIP,
+		"port":      port,
+		"timeout":   timeout.String(),
+	})
+
+	if err := waitForTCPPort(ctx, server.PublicIP, port, timeout, 10*time.Second); err != nil {
+		resp.Diagnostics.AddError(
+			"Server Readiness Timeout",
+			fmt.Sprintf("Server %s was provisioned but did not accept connections on %s within %s: %s", server.ID, net.JoinHostPort(server.PublicIP, strconv.Itoa(port)), timeout, err),
+		)
+	}
 }
 
 func (r *BareMetalServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -408,16 +774,29 @@ func (r *BareMetalServerResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get current state from API
-	serviceID := int(data.ServiceID.ValueInt64())
-	server, err := r.client.GetServerByServiceID(serviceID)
+	server, err := r.getServer(ctx, &data)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Server no longer exists, removing from state", map[string]interface{}{
+			"id":         data.ID.ValueString(),
+			"service_id": data.ServiceID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server with service ID %d, got error: %s", serviceID, err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server %s (service ID %d), got error: %s", data.ID.ValueString(), data.ServiceID.ValueInt64(), err))
 		return
 	}
 
 	// Update the model with current server state
 	r.updateModelFromServer(&data, server)
 
+	// Pick up changes made outside Terraform
+	data.Hostname = types.StringValue(server.Hostname)
+	data.FriendlyName = types.StringValue(server.FriendlyName)
+	r.updateOrderAttributesFromServer(&data, server)
+	r.updateTagsFromServer(&data, server)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -434,9 +813,28 @@ func (r *BareMetalServerResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	// Attributes that cannot change in place carry RequiresReplace plan modifiers,
+	// so only the in-place updatable attributes are handled here.
+	serverID := state.ID.ValueString()
+
+	// Check if hostname changed
+	if !plan.Hostname.Equal(state.Hostname) && !plan.Hostname.IsNull() && !plan.Hostname.IsUnknown() {
+		hostname := plan.Hostname.ValueString()
+
+		tflog.Info(ctx, "Updating server hostname", map[string]interface{}{
+			"server_id": serverID,
+			"hostname":  hostname,
+		})
+
+		err := r.client.UpdateServerHostname(ctx, serverID, hostname)
+		if err != nil {
+			resp.Diagnostics.AddError("Hostname Update Failed", fmt.Sprintf("Unable to update server hostname: %s", err))
+			return
+		}
+	}
+
 	// Check if friendly name changed
-	if !plan.FriendlyName.Equal(state.FriendlyName) && !plan.FriendlyName.IsNull() {
-		serverID := state.ID.ValueString()
+	if !plan.FriendlyName.Equal(state.FriendlyName) && !plan.FriendlyName.IsNull() && !plan.FriendlyName.IsUnknown() {
 		friendlyName := plan.FriendlyName.ValueString()
 
 		tflog.Info(ctx, "Updating server friendly name", map[string]interface{}{
@@ -444,22 +842,76 @@ func (r *BareMetalServerResource) Update(ctx context.Context, req resource.Updat
 			"friendly_name": friendlyName,
 		})
 
-		err := r.client.UpdateServerFriendlyName(serverID, friendlyName)
+		err := r.client.UpdateServerFriendlyName(ctx, serverID, friendlyName)
 		if err != nil {
 			resp.Diagnostics.AddError("Friendly Name Update Failed", fmt.Sprintf("Unable to update server friendly name: %s", err))
 			return
 		}
 	}
 
-	// For other changes, require replacement
-	if !plan.InstanceType.Equal(state.InstanceType) ||
-		!plan.Location.Equal(state.Location) ||
-		!plan.OperatingSystem.Equal(state.OperatingSystem) ||
-		!plan.Hostname.Equal(state.Hostname) ||
-		!plan.SSHKeyLabels.Equal(state.SSHKeyLabels) {
-		resp.Diagnostics.AddWarning("Update Requires Replacement", "Changes to instance_type, location, operating_system, hostname, or ssh_key_labels require resource replacement. Please destroy and recreate the resource.")
+	// Check if SSH keys changed
+	if !plan.SSHKeyLabels.Equal(state.SSHKeyLabels) {
+		var oldLabels, newLabels []string
+		if !state.SSHKeyLabels.IsNull() {
+			resp.Diagnostics.Append(state.SSHKeyLabels.ElementsAs(ctx, &oldLabels, false)...)
+		}
+		if !plan.SSHKeyLabels.IsNull() {
+			resp.Diagnostics.Append(plan.SSHKeyLabels.ElementsAs(ctx, &newLabels, false)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(r.updateServerSSHKeys(ctx, serverID, oldLabels, newLabels)...)
+		if resp.Diagnostics.HasError() {
+			// Some keys may already have changed, so save what is actually
+			// attached for the next plan to work from
+			attached, err := r.attachedSSHKeyLabels(ctx, serverID, newLabels)
+			if err != nil {
+				tflog.Warn(ctx, "Unable to read SSH keys after a failed update", map[string]interface{}{
+					"server_id": serverID,
+					"error":     err.Error(),
+				})
+				return
+			}
+
+			state.Hostname = plan.Hostname
+			state.FriendlyName = plan.FriendlyName
+			state.SSHKeyLabels = attached
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	// Check if tags changed
+	if !plan.TagsAll.Equal(state.TagsAll) {
+		var tagsAll map[string]string
+		resp.Diagnostics.Append(plan.TagsAll.ElementsAs(ctx, &tagsAll, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Info(ctx, "Updating server tags", map[string]interface{}{
+			"server_id": serverID,
+			"tags":      tagsAll,
+		})
+
+		err := r.client.SetServerTags(ctx, serverID, tagsAll)
+		if err != nil {
+			resp.Diagnostics.AddError("Tags Update Failed", fmt.Sprintf("Unable to update server tags: %s", err))
+			return
+		}
+	}
+
+	// Refresh computed attributes from the API
+	server, err := r.getServer(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server %s after update, got error: %s", serverID, err))
+		return
 	}
 
+	r.updateModelFromServer(&plan, server)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -474,9 +926,18 @@ func (r *BareMetalServerResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	// Since we always use hourly billing, we can cancel via API
 	serverID := data.ID.ValueString()
-	err := r.client.CancelServer(serverID)
+
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Server %s (service ID %d) has deletion_protection enabled and was not cancelled. Set deletion_protection = false and apply before destroying or replacing this server.", serverID, data.ServiceID.ValueInt64()),
+		)
+		return
+	}
+
+	// Since we always use hourly billing, we can cancel via API
+	err := r.client.CancelServer(ctx, serverID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to cancel server %s, got error: %s", serverID, err))
 		return
@@ -488,27 +949,227 @@ func (r *BareMetalServerResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *BareMetalServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by service ID
-	serviceIDStr := req.ID
-	serviceID, err := strconv.Atoi(serviceIDStr)
+	servers, err := r.client.GetServers(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid service ID format: %s", serviceIDStr))
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to list servers: %s", err))
 		return
 	}
 
-	server, err := r.client.GetServerByServiceID(serviceID)
+	server, err := findServerForImport(servers, req.ID)
 	if err != nil {
-		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to find server with service ID %d: %s", serviceID, err))
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import server %q: %s", req.ID, err))
 		return
 	}
 
 	// Set the state
 	var data BareMetalServerResourceModel
 	r.updateModelFromServer(&data, server)
+	r.updateOrderAttributesFromServer(&data, server)
+	r.updateTagsFromServer(&data, server)
+	data.DeletionProtection = types.BoolValue(false)
+	data.StoreRootPassword = types.BoolValue(true)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// findServerForImport resolves an import identifier to a single server. Supported
+// forms are a bare service ID, "service_id:<n>", "id:<server id>",
+// "hostname:<hostname>" and "friendly_name:<name>".
+func findServerForImport(servers []Server, importID string) (*Server, error) {
+	key, value, found := strings.Cut(importID, ":")
+	if !found {
+		key, value = "service_id", importID
+	}
+
+	var match func(Server) bool
+	switch key {
+	case "service_id":
+		serviceID, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid import identifier %q, use a service ID or one of the prefixes service_id:, id:, hostname: or friendly_name:", importID)
+		}
+		match = func(s Server) bool { return s.ServiceID == serviceID }
+	case "id":
+		match = func(s Server) bool { return s.ID == value }
+	case "hostname":
+		match = func(s Server) bool { return s.Hostname == value }
+	case "friendly_name":
+		match = func(s Server) bool { return s.FriendlyName == value }
+	default:
+		return nil, fmt.Errorf("unsupported import prefix %q, use one of service_id:, id:, hostname: or friendly_name:", key)
+	}
+
+	var matches []Server
+	for _, server := range servers {
+		if match(server) {
+			matches = append(matches, server)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no server found matching %s %q", key, value)
+	case 1:
+		return &matches[0], nil
+	}
+
+	var candidates []string
+	for _, server := range matches {
+		candidates = append(candidates, fmt.Sprintf("\n  id:%s (service ID %d, hostname %q, friendly name %q)", server.ID, server.ServiceID, server.Hostname, server.FriendlyName))
+	}
+
+	return nil, fmt.Errorf("%d servers match %s %q, import one of them by ID instead:%s", len(matches), key, value, strings.Join(candidates, ""))
+}
+
+// requiresReplaceUnlessImported forces replacement when the attribute changes,
+// except when the prior state has no value. Servers imported from an API that
+// does not report the order-time attributes adopt the configured values in place.
+func requiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Changing this value requires replacement, unless the value is unset because the server was imported.",
+		"Changing this value requires replacement, unless the value is unset because the server was imported.",
+	)
+}
+
+// updateServerSSHKeys assigns and removes SSH keys so the server matches newLabels
+func (r *BareMetalServerResource) updateServerSSHKeys(ctx context.Context, serverID string, oldLabels, newLabels []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sshKeys, err := r.client.GetSSHKeys(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read SSH keys, got error: %s", err))
+		return diags
+	}
+
+	keyIDs := make(map[string]int, len(sshKeys))
+	for _, key := range sshKeys {
+		keyIDs[key.Label] = key.ID
+	}
+
+	add, remove := sshKeyChanges(oldLabels, newLabels)
+
+	for _, label := range add {
+		keyID, ok := keyIDs[label]
+		if !ok {
+			diags.AddError(
+				"SSH Key Not Found",
+				fmt.Sprintf("SSH key with label '%s' not found. Please ensure the SSH key exists before adding it to the server.", label),
+			)
+			return diags
+		}
+
+		tflog.Info(ctx, "Adding SSH key to server", map[string]interface{}{
+			"server_id":     serverID,
+			"ssh_key_label": label,
+			"ssh_key_id":    keyID,
+		})
+
+		if err := r.client.AssignSSHKeyToServer(ctx, serverID, keyID); err != nil {
+			diags.AddError("SSH Key Update Failed", fmt.Sprintf("Unable to add SSH key '%s' to server %s: %s", label, serverID, err))
+			return diags
+		}
+	}
+
+	for _, label := range remove {
+		// A key that no longer exists cannot be assigned to the server anymore
+		keyID, ok := keyIDs[label]
+		if !ok {
+			continue
+		}
+
+		tflog.Info(ctx, "Removing SSH key from server", map[string]interface{}{
+			"server_id":     serverID,
+			"ssh_key_label": label,
+			"ssh_key_id":    keyID,
+		})
+
+		if err := r.client.RemoveSSHKeyFromServer(ctx, serverID, keyID); err != nil {
+			diags.AddError("SSH Key Update Failed", fmt.Sprintf("Unable to remove SSH key '%s' from server %s: %s", label, serverID, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// sshKeyChanges returns the labels to assign and to remove to go from
+// oldLabels to newLabels, in the order they appear
+func sshKeyChanges(oldLabels, newLabels []string) (add, remove []string) {
+	oldSet := make(map[string]bool, len(oldLabels))
+	for _, label := range oldLabels {
+		oldSet[label] = true
+	}
+	newSet := make(map[string]bool, len(newLabels))
+	for _, label := range newLabels {
+		newSet[label] = true
+	}
+
+	for _, label := range newLabels {
+		if !oldSet[label] {
+			add = append(add, label)
+			oldSet[label] = true
+		}
+	}
+	for _, label := range oldLabels {
+		if !newSet[label] {
+			remove = append(remove, label)
+			newSet[label] = true
+		}
+	}
+
+	return add, remove
+}
+
+// attachedSSHKeyLabels returns the labels of the SSH keys assigned to the
+// server, in the order of preferred followed by any others sorted by label
+func (r *BareMetalServerResource) attachedSSHKeyLabels(ctx context.Context, serverID string, preferred []string) (types.List, error) {
+	sshKeys, err := r.client.GetSSHKeys(ctx)
+	if err != nil {
+		return types.ListNull(types.StringType), err
+	}
+
+	attached := make(map[string]bool)
+	for _, key := range sshKeys {
+		for _, server := range key.AssignedServers {
+			if server.ServerID == serverID {
+				attached[key.Label] = true
+			}
+		}
+	}
+
+	labels := []attr.Value{}
+	for _, label := range preferred {
+		if attached[label] {
+			labels = append(labels, types.StringValue(label))
+			delete(attached, label)
+		}
+	}
+
+	others := make([]string, 0, len(attached))
+	for label := range attached {
+		others = append(others, label)
+	}
+	sort.Strings(others)
+	for _, label := range others {
+		labels = append(labels, types.StringValue(label))
+	}
+
+	return types.ListValueMust(types.StringType, labels), nil
+}
+
+// getServer fetches the server from the single-server endpoint, falling back
+// to a lookup by service ID when the server ID is not known yet
+func (r *BareMetalServerResource) getServer(ctx context.Context, data *BareMetalServerResourceModel) (*Server, error) {
+	if serverID := data.ID.ValueString(); serverID != "" {
+		return r.client.GetServer(ctx, serverID)
+	}
+
+	return r.client.GetServerByServiceID(ctx, int(data.ServiceID.ValueInt64()))
+}
+
 // waitForServerProvisioning waits for a server to be provisioned
 func (r *BareMetalServerResource) waitForServerProvisioning(ctx context.Context, serviceID int, timeout time.Duration) (*Server, error) {
 	deadline := time.Now().Add(timeout)
@@ -524,7 +1185,7 @@ func (r *BareMetalServerResource) waitForServerProvisioning(ctx context.Context,
 			"service_id": serviceID,
 		})
 
-		server, err := r.client.GetServerByServiceID(serviceID)
+		server, err := r.client.GetServerByServiceID(ctx, serviceID)
 		if err == nil {
 			// Server found, provisioning complete
 			return server, nil
@@ -537,12 +1198,144 @@ func (r *BareMetalServerResource) waitForServerProvisioning(ctx context.Context,
 	return nil, fmt.Errorf("timeout waiting for server with service ID %d to be provisioned", serviceID)
 }
 
+// userDataFromConfig returns the decoded user data from either user_data or
+// user_data_base64. The result is null when neither attribute is set.
+func userDataFromConfig(ctx context.Context, config tfsdk.Config) (types.String, diag.Diagnostics) {
+	var userData, userDataBase64 types.String
+
+	diags := config.GetAttribute(ctx, path.Root("user_data"), &userData)
+	diags.Append(config.GetAttribute(ctx, path.Root("user_data_base64"), &userDataBase64)...)
+
+	if diags.HasError() {
+		return types.StringNull(), diags
+	}
+
+	if userDataBase64.IsNull() {
+		return userData, diags
+	}
+
+	if userDataBase64.IsUnknown() {
+		return types.StringUnknown(), diags
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(userDataBase64.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("user_data_base64"),
+			"Invalid User Data",
+			fmt.Sprintf("user_data_base64 is not valid base64: %s", err),
+		)
+		return types.StringNull(), diags
+	}
+
+	return types.StringValue(string(decoded)), diags
+}
+
+// userDataHash returns the hex-encoded SHA-256 hash of the user data
+func userDataHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// waitForTCPPort polls host:port until it accepts a TCP connection or the timeout expires
+func waitForTCPPort(ctx context.Context, host string, port int, timeout, interval time.Duration) error {
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	deadline := time.Now().Add(timeout)
+	dialer := net.Dialer{Timeout: 5 * time.Second}
+
+	var lastErr error
+	for {
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err == nil {
+			conn.Close()
+			return nil
+		}
+		lastErr = err
+
+		tflog.Debug(ctx, "Server not accepting connections yet", map[string]interface{}{
+			"address": address,
+			"error":   err.Error(),
+		})
+
+		if time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("timeout waiting for %s: %w", address, lastErr)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// validateLocation adds an error if the location code is not one of the account's locations.
+// A rejected API token is reported; other failures to list locations skip validation,
+// leaving it to the order checks.
+func (r *BareMetalServerResource) validateLocation(ctx context.Context, location string, diags *diag.Diagnostics) {
+	if r.client == nil {
+		return
+	}
+
+	locations, err := r.client.GetLocations(ctx)
+	if errors.Is(err, ErrUnauthorized) {
+		diags.AddError("Invalid API Token", "The API token was rejected by the ICS API. Check that the token is correct and has not been revoked.")
+		return
+	}
+	if err != nil || len(locations) == 0 {
+		tflog.Warn(ctx, "Unable to list locations, skipping location validation", map[string]interface{}{
+			"error": fmt.Sprint(err),
+		})
+		return
+	}
+
+	var codes []string
+	for _, l := range locations {
+		if l.Code == location {
+			return
+		}
+		codes = append(codes, l.Code)
+	}
+	sort.Strings(codes)
+
+	diags.AddAttributeError(
+		path.Root("location"),
+		"Invalid Location",
+		fmt.Sprintf("Location '%s' does not exist.\n\nAvailable locations: %s\n\nUse the ics_locations data source to list locations with their datacenter and inventory.", location, strings.Join(codes, ", ")),
+	)
+}
+
+// serverIPAddresses returns all IP addresses routed to the server and the IPv6 subset.
+// Servers without any reported addresses fall back to the primary public IP.
+func serverIPAddresses(server *Server) (types.List, types.List) {
+	all := []attr.Value{}
+	ipv6 := []attr.Value{}
+
+	for _, address := range server.IPAddresses {
+		all = append(all, types.StringValue(address.Address))
+		if address.Version == 6 {
+			ipv6 = append(ipv6, types.StringValue(address.Address))
+		}
+	}
+
+	if len(all) == 0 && server.PublicIP != "" {
+		all = append(all, types.StringValue(server.PublicIP))
+	}
+
+	return types.ListValueMust(types.StringType, all), types.ListValueMust(types.StringType, ipv6)
+}
+
 // updateModelFromServer updates the Terraform model with server data
 func (r *BareMetalServerResource) updateModelFromServer(data *BareMetalServerResourceModel, server *Server) {
 	data.ID = types.StringValue(server.ID)
 	data.ServiceID = types.Int64Value(int64(server.ServiceID))
 	data.PublicIP = types.StringValue(server.PublicIP)
+	data.IPAddresses, data.IPv6Addresses = serverIPAddresses(server)
+	data.PrivateIPAddresses = serverPrivateIPAddresses(server)
 	data.RootPassword = types.StringValue(server.RootPassword)
+	if !data.StoreRootPassword.IsNull() && !data.StoreRootPassword.ValueBool() {
+		data.RootPassword = types.StringNull()
+	}
 	data.ServiceDescription = types.StringValue(server.ServiceDescription)
 	data.PlanID = types.Int64Value(int64(server.PlanID))
 	data.DatacenterName = types.StringValue(server.DatacenterName)
@@ -551,10 +1344,81 @@ func (r *BareMetalServerResource) updateModelFromServer(data *BareMetalServerRes
 	data.ServerTypeInternal = types.StringValue(server.ServerType)
 
 	// Update input fields if they were computed
-	if data.Hostname.IsNull() && server.Hostname != "" {
+	if data.Hostname.IsNull() || data.Hostname.IsUnknown() {
 		data.Hostname = types.StringValue(server.Hostname)
 	}
-	if data.FriendlyName.IsNull() && server.FriendlyName != "" {
+	if data.FriendlyName.IsNull() || data.FriendlyName.IsUnknown() {
 		data.FriendlyName = types.StringValue(server.FriendlyName)
 	}
+}
+
+// serverPrivateIPAddresses returns the server's addresses on attached private networks
+func serverPrivateIPAddresses(server *Server) types.List {
+	addresses := []attr.Value{}
+	for _, network := range server.PrivateNetworks {
+		addresses = append(addresses, types.StringValue(network.PrivateIP))
+	}
+
+	return types.ListValueMust(types.StringType, addresses)
+}
+
+// updateOrderAttributesFromServer sets instance_type, location and operating_system
+// from the API. Values the API does not report are left untouched.
+func (r *BareMetalServerResource) updateOrderAttributesFromServer(data *BareMetalServerResourceModel, server *Server) {
+	if server.SkuProductName != "" {
+		data.InstanceType = types.StringValue(server.SkuProductName)
+	}
+	if server.LocationCode != "" {
+		data.Location = types.StringValue(server.LocationCode)
+	}
+	if server.OperatingSystem != "" {
+		data.OperatingSystem = types.StringValue(server.OperatingSystem)
+	}
+}
+
+// allTags merges the provider default tags with the configured tags
+func (r *BareMetalServerResource) allTags(tags types.Map) (types.Map, diag.Diagnostics) {
+	if tags.IsUnknown() {
+		return types.MapUnknown(types.StringType), nil
+	}
+
+	merged := make(map[string]attr.Value)
+	if r.client != nil {
+		for key, value := range r.client.DefaultTags {
+			merged[key] = types.StringValue(value)
+		}
+	}
+	for key, value := range tags.Elements() {
+		merged[key] = value
+	}
+
+	return types.MapValue(types.StringType, merged)
+}
+
+// updateTagsFromServer sets tags_all from the API and tags to everything that
+// is not inherited unchanged from the provider default tags
+func (r *BareMetalServerResource) updateTagsFromServer(data *BareMetalServerResourceModel, server *Server) {
+	var defaultTags map[string]string
+	if r.client != nil {
+		defaultTags = r.client.DefaultTags
+	}
+
+	configured := data.Tags.Elements()
+	all := make(map[string]attr.Value, len(server.Tags))
+	own := make(map[string]attr.Value)
+
+	for key, value := range server.Tags {
+		all[key] = types.StringValue(value)
+
+		_, isConfigured := configured[key]
+		if defaultValue, ok := defaultTags[key]; ok && defaultValue == value && !isConfigured {
+			continue
+		}
+		own[key] = types.StringValue(value)
+	}
+
+	data.TagsAll = types.MapValueMust(types.StringType, all)
+	if len(own) > 0 || !data.Tags.IsNull() {
+		data.Tags = types.MapValueMust(types.StringType, own)
+	}
 }
\ No newline at end of file
@@ -0,0 +1,799 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWaitForTCPPort(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start listener: %s", err)
+	}
+	defer listener.Close()
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	if err := waitForTCPPort(context.Background(), "127.0.0.1", port, time.Second, 10*time.Millisecond); err != nil {
+		t.Fatalf("expected port %d to be reachable, got: %s", port, err)
+	}
+}
+
+func TestWaitForTCPPortTimeout(t *testing.T) {
+	// Grab a free port and release it so nothing is listening
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start listener: %s", err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	if err := waitForTCPPort(context.Background(), "127.0.0.1", port, 100*time.Millisecond, 10*time.Millisecond); err == nil {
+		t.Fatal("expected timeout waiting for closed port")
+	}
+}
+
+func TestCreateInvalidReadinessTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	r := &BareMetalServerResource{client: NewICSClient("token", server.URL)}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	// readiness_timeout was unknown during validation and resolved to an
+	// invalid duration at apply time
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["instance_type"] = tftypes.NewValue(tftypes.String, "c1.small")
+	values["location"] = tftypes.NewValue(tftypes.String, "NYC1")
+	values["operating_system"] = tftypes.NewValue(tftypes.String, "Ubuntu 24.04")
+	values["wait_for_ssh"] = tftypes.NewValue(tftypes.Bool, true)
+	values["readiness_timeout"] = tftypes.NewValue(tftypes.String, "ten minutes")
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(context.Background(), req, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for an invalid readiness_timeout")
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected no state to be written")
+	}
+}
+
+func TestFindServerForImport(t *testing.T) {
+	servers := []Server{
+		{ID: "abc123", ServiceID: 1001, Hostname: "db-01", FriendlyName: "primary"},
+		{ID: "def456", ServiceID: 1002, Hostname: "db-02", FriendlyName: "replica"},
+		{ID: "ghi789", ServiceID: 1003, Hostname: "web-01", FriendlyName: "replica"},
+	}
+
+	tests := []struct {
+		importID string
+		wantID   string
+		wantErr  bool
+	}{
+		{importID: "1002", wantID: "def456"},
+		{importID: "service_id:1003", wantID: "ghi789"},
+		{importID: "id:abc123", wantID: "abc123"},
+		{importID: "hostname:db-01", wantID: "abc123"},
+		{importID: "friendly_name:primary", wantID: "abc123"},
+		{importID: "friendly_name:replica", wantErr: true},
+		{importID: "hostname:missing", wantErr: true},
+		{importID: "db-01", wantErr: true},
+		{importID: "label:db-01", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		server, err := findServerForImport(servers, tt.importID)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected error, got server %s", tt.importID, server.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tt.importID, err)
+			continue
+		}
+		if server.ID != tt.wantID {
+			t.Errorf("%s: expected server %s, got %s", tt.importID, tt.wantID, server.ID)
+		}
+	}
+}
+
+// testServerSchema returns the bare metal server resource schema
+func testServerSchema(t *testing.T) schema.Schema {
+	t.Helper()
+
+	var resp resource.SchemaResponse
+	NewBareMetalServerResource().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", resp.Diagnostics)
+	}
+
+	return resp.Schema
+}
+
+// testServerValue builds a bare metal server object with every attribute not
+// in values set to null
+func testServerValue(t *testing.T, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objectType := testServerSchema(t).Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+			continue
+		}
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	return tftypes.NewValue(objectType, attributes)
+}
+
+func testServerConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	return tfsdk.Config{Schema: testServerSchema(t), Raw: testServerValue(t, values)}
+}
+
+func TestUserDataFromConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[string]tftypes.Value
+		want    types.String
+		wantErr bool
+	}{
+		{
+			name: "neither set",
+			want: types.StringNull(),
+		},
+		{
+			name:   "user_data",
+			values: map[string]tftypes.Value{"user_data": tftypes.NewValue(tftypes.String, "#cloud-config\n")},
+			want:   types.StringValue("#cloud-config\n"),
+		},
+		{
+			name:   "user_data_base64 is decoded",
+			values: map[string]tftypes.Value{"user_data_base64": tftypes.NewValue(tftypes.String, base64.StdEncoding.EncodeToString([]byte("#cloud-config\n")))},
+			want:   types.StringValue("#cloud-config\n"),
+		},
+		{
+			name:   "unknown user_data_base64",
+			values: map[string]tftypes.Value{"user_data_base64": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+			want:   types.StringUnknown(),
+		},
+		{
+			name:    "invalid base64",
+			values:  map[string]tftypes.Value{"user_data_base64": tftypes.NewValue(tftypes.String, "not base64!")},
+			want:    types.StringNull(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := userDataFromConfig(context.Background(), testServerConfig(t, tt.values))
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tt.wantErr, diags)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUserDataHash(t *testing.T) {
+	// sha256("hello")
+	if got := userDataHash("hello"); got != "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" {
+		t.Errorf("unexpected hash %s", got)
+	}
+}
+
+func TestValidateConfigUserData(t *testing.T) {
+	tooLarge := strings.Repeat("a", maxUserDataSize+1)
+
+	tests := []struct {
+		name     string
+		values   map[string]tftypes.Value
+		wantPath path.Path
+	}{
+		{
+			name:   "user_data within limit",
+			values: map[string]tftypes.Value{"user_data": tftypes.NewValue(tftypes.String, "#cloud-config\n")},
+		},
+		{
+			name: "both set",
+			values: map[string]tftypes.Value{
+				"user_data":        tftypes.NewValue(tftypes.String, "#cloud-config\n"),
+				"user_data_base64": tftypes.NewValue(tftypes.String, "I2Nsb3VkLWNvbmZpZwo="),
+			},
+			wantPath: path.Root("user_data_base64"),
+		},
+		{
+			name:     "user_data too large",
+			values:   map[string]tftypes.Value{"user_data": tftypes.NewValue(tftypes.String, tooLarge)},
+			wantPath: path.Root("user_data"),
+		},
+		{
+			name:     "user_data_base64 too large",
+			values:   map[string]tftypes.Value{"user_data_base64": tftypes.NewValue(tftypes.String, base64.StdEncoding.EncodeToString([]byte(tooLarge)))},
+			wantPath: path.Root("user_data_base64"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp resource.ValidateConfigResponse
+			r := &BareMetalServerResource{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: testServerConfig(t, tt.values)}, &resp)
+
+			if len(tt.wantPath.Steps()) == 0 {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != 1 {
+				t.Fatalf("expected one error, got: %v", resp.Diagnostics)
+			}
+			withPath, ok := errs[0].(interface{ Path() path.Path })
+			if !ok || !withPath.Path().Equal(tt.wantPath) {
+				t.Errorf("expected the error on %s, got: %v", tt.wantPath, errs[0])
+			}
+		})
+	}
+}
+
+// testModifyServerPlan runs ModifyPlan for an update from state to a plan
+// and config that share the given values
+func testModifyServerPlan(t *testing.T, r *BareMetalServerResource, state, plan, config map[string]tftypes.Value) resource.ModifyPlanResponse {
+	t.Helper()
+
+	serverSchema := testServerSchema(t)
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: serverSchema, Raw: testServerValue(t, config)},
+		Plan:   tfsdk.Plan{Schema: serverSchema, Raw: testServerValue(t, plan)},
+		State:  tfsdk.State{Schema: serverSchema, Raw: testServerValue(t, state)},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+	r.ModifyPlan(context.Background(), req, &resp)
+
+	return resp
+}
+
+// testServerAttributes returns the attributes of an existing server
+func testServerAttributes(overrides map[string]tftypes.Value) map[string]tftypes.Value {
+	values := map[string]tftypes.Value{
+		"id":               tftypes.NewValue(tftypes.String, "abc123"),
+		"instance_type":    tftypes.NewValue(tftypes.String, "c1.small"),
+		"location":         tftypes.NewValue(tftypes.String, "NYC1"),
+		"operating_system": tftypes.NewValue(tftypes.String, "Ubuntu 24.04"),
+		"service_id":       tftypes.NewValue(tftypes.Number, 1001),
+	}
+	for key, value := range overrides {
+		values[key] = value
+	}
+
+	return values
+}
+
+func TestModifyPlanUserDataOnServerWithoutHash(t *testing.T) {
+	userData := map[string]tftypes.Value{"user_data": tftypes.NewValue(tftypes.String, "#cloud-config\n")}
+
+	// Adding user data to a server ordered without it must not record a hash
+	resp := testModifyServerPlan(t, &BareMetalServerResource{}, testServerAttributes(nil), testServerAttributes(nil), testServerAttributes(userData))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(resp.Diagnostics.Warnings()) != 1 {
+		t.Errorf("expected a warning that user data is not applied, got: %v", resp.Diagnostics)
+	}
+	if len(resp.RequiresReplace) != 0 {
+		t.Errorf("expected no replacement, got: %v", resp.RequiresReplace)
+	}
+
+	var hash types.String
+	resp.Plan.GetAttribute(context.Background(), path.Root("user_data_hash"), &hash)
+	if !hash.IsNull() {
+		t.Errorf("expected user_data_hash to stay null, got %s", hash)
+	}
+
+	// When the server is replaced anyway, the new order applies the user data
+	replaced := map[string]tftypes.Value{"instance_type": tftypes.NewValue(tftypes.String, "c1.large")}
+	config := testServerAttributes(replaced)
+	config["user_data"] = userData["user_data"]
+	resp = testModifyServerPlan(t, &BareMetalServerResource{}, testServerAttributes(nil), testServerAttributes(replaced), config)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	resp.Plan.GetAttribute(context.Background(), path.Root("user_data_hash"), &hash)
+	if hash.ValueString() != userDataHash("#cloud-config\n") {
+		t.Errorf("expected the planned hash of the user data, got %s", hash)
+	}
+}
+
+func TestModifyPlanValidateLocation(t *testing.T) {
+	var requests int
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest-api/locations" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			fmt.Fprintf(w, `{"statusCode":%d,"message":"Error"}`, status)
+			return
+		}
+		w.Write([]byte(`{"statusCode":200,"message":"OK","data":[{"location_code":"NYC1"},{"location_code":"AMS1"}]}`))
+	}))
+	defer server.Close()
+
+	r := &BareMetalServerResource{client: NewICSClient("token", server.URL)}
+	serverSchema := testServerSchema(t)
+	objectType := serverSchema.Type().TerraformType(context.Background())
+
+	location := func(code string) map[string]tftypes.Value {
+		return testServerAttributes(map[string]tftypes.Value{"location": tftypes.NewValue(tftypes.String, code)})
+	}
+
+	tests := map[string]struct {
+		state        map[string]tftypes.Value
+		location     string
+		status       int
+		wantRequests int
+		wantErr      string
+	}{
+		"create":                     {location: "AMS1", wantRequests: 1},
+		"create in unknown location": {location: "SFO1", wantRequests: 1, wantErr: "Invalid Location"},
+		"location unchanged":         {state: location("NYC1"), location: "NYC1"},
+		"location changed":           {state: location("NYC1"), location: "AMS1", wantRequests: 1},
+		"rejected token":             {location: "AMS1", status: http.StatusUnauthorized, wantRequests: 1, wantErr: "Invalid API Token"},
+		"locations unavailable":      {location: "SFO1", status: http.StatusInternalServerError, wantRequests: 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			requests = 0
+			status = http.StatusOK
+			if tt.status != 0 {
+				status = tt.status
+			}
+
+			state := tftypes.NewValue(objectType, nil)
+			if tt.state != nil {
+				state = testServerValue(t, tt.state)
+			}
+			plan := testServerValue(t, location(tt.location))
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: serverSchema, Raw: plan},
+				Plan:   tfsdk.Plan{Schema: serverSchema, Raw: plan},
+				State:  tfsdk.State{Schema: serverSchema, Raw: state},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(context.Background(), req, &resp)
+
+			if requests != tt.wantRequests {
+				t.Errorf("expected %d locations requests, got %d", tt.wantRequests, requests)
+			}
+
+			if tt.wantErr == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if errs := resp.Diagnostics.Errors(); len(errs) != 1 || errs[0].Summary() != tt.wantErr {
+				t.Errorf("expected a %q error, got: %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSSHKeyChanges(t *testing.T) {
+	tests := map[string]struct {
+		oldLabels, newLabels []string
+		wantAdd, wantRemove  []string
+	}{
+		"unchanged":   {[]string{"a", "b"}, []string{"b", "a"}, nil, nil},
+		"add":         {[]string{"a"}, []string{"a", "b", "c"}, []string{"b", "c"}, nil},
+		"remove":      {[]string{"a", "b"}, nil, nil, []string{"a", "b"}},
+		"replace":     {[]string{"a", "b"}, []string{"b", "c"}, []string{"c"}, []string{"a"}},
+		"duplicates":  {[]string{"a", "a"}, []string{"c", "c"}, []string{"c"}, []string{"a"}},
+		"empty lists": {nil, nil, nil, nil},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			add, remove := sshKeyChanges(tt.oldLabels, tt.newLabels)
+			if strings.Join(add, ",") != strings.Join(tt.wantAdd, ",") {
+				t.Errorf("expected to add %v, got %v", tt.wantAdd, add)
+			}
+			if strings.Join(remove, ",") != strings.Join(tt.wantRemove, ",") {
+				t.Errorf("expected to remove %v, got %v", tt.wantRemove, remove)
+			}
+		})
+	}
+}
+
+func TestUpdateServerSSHKeysPartialFailure(t *testing.T) {
+	// Key 1 starts attached; assigning key 2 succeeds and key 3 fails
+	attached := map[int]bool{1: true}
+	labels := map[int]string{1: "alpha", 2: "bravo", 3: "charlie", 4: "delta"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest-api/ssh-keys":
+			keys := []SSHKey{}
+			for id := 1; id <= 4; id++ {
+				key := SSHKey{ID: id, Label: labels[id]}
+				if attached[id] {
+					key.AssignedServers = []AssignedServer{{ServerID: "abc123"}}
+				}
+				if id == 4 {
+					key.AssignedServers = []AssignedServer{{ServerID: "other"}}
+				}
+				keys = append(keys, key)
+			}
+			json.NewEncoder(w).Encode(APIResponse{StatusCode: 200, Data: keys})
+		case r.Method == "POST" && r.URL.Path == "/rest-api/servers/abc123/ssh-keys":
+			var request ServerSSHKeyRequest
+			json.NewDecoder(r.Body).Decode(&request)
+			if request.SSHKeyID == 3 {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"statusCode":500,"message":"Server error"}`))
+				return
+			}
+			attached[request.SSHKeyID] = true
+			w.Write([]byte(`{"statusCode":200,"message":"OK"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &BareMetalServerResource{client: NewICSClient("token", server.URL)}
+	newLabels := []string{"bravo", "charlie"}
+
+	diags := r.updateServerSSHKeys(context.Background(), "abc123", []string{"alpha"}, newLabels)
+	if !diags.HasError() {
+		t.Fatal("expected an error when assigning a key fails")
+	}
+
+	got, err := r.attachedSSHKeyLabels(context.Background(), "abc123", newLabels)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// bravo was assigned before the failure and alpha was never removed
+	want := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("bravo"), types.StringValue("alpha")})
+	if !got.Equal(want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestModifyPlanDeletionProtection(t *testing.T) {
+	protected := map[string]tftypes.Value{"deletion_protection": tftypes.NewValue(tftypes.Bool, true)}
+
+	tests := map[string]struct {
+		plan      map[string]tftypes.Value
+		config    map[string]tftypes.Value
+		wantError bool
+	}{
+		"in-place update": {
+			plan: map[string]tftypes.Value{"hostname": tftypes.NewValue(tftypes.String, "web-2")},
+		},
+		"instance_type change": {
+			plan:      map[string]tftypes.Value{"instance_type": tftypes.NewValue(tftypes.String, "c1.large")},
+			wantError: true,
+		},
+		"location change": {
+			plan:      map[string]tftypes.Value{"location": tftypes.NewValue(tftypes.String, "LAX1")},
+			wantError: true,
+		},
+		"operating_system change": {
+			plan:      map[string]tftypes.Value{"operating_system": tftypes.NewValue(tftypes.String, "Debian 12")},
+			wantError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := testServerAttributes(protected)
+			for key, value := range tt.plan {
+				plan[key] = value
+			}
+
+			// The error does not depend on warn_on_server_replacement
+			resp := testModifyServerPlan(t, &BareMetalServerResource{}, testServerAttributes(protected), plan, plan)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("expected error %t, got: %v", tt.wantError, resp.Diagnostics)
+			}
+
+			// Without deletion protection the replacement is allowed
+			resp = testModifyServerPlan(t, &BareMetalServerResource{}, testServerAttributes(nil), testServerAttributes(tt.plan), testServerAttributes(tt.plan))
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected diagnostics without deletion protection: %v", resp.Diagnostics)
+			}
+		})
+	}
+
+	// Changing user data on a server ordered with it forces replacement too
+	hash := map[string]tftypes.Value{
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, true),
+		"user_data_hash":      tftypes.NewValue(tftypes.String, userDataHash("old")),
+	}
+	config := testServerAttributes(protected)
+	config["user_data"] = tftypes.NewValue(tftypes.String, "new")
+	resp := testModifyServerPlan(t, &BareMetalServerResource{}, testServerAttributes(hash), testServerAttributes(hash), config)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error when user data changes on a protected server")
+	}
+}
+
+// testStringMap builds a types.Map of strings, or a null map for nil
+func testStringMap(values map[string]string) types.Map {
+	if values == nil {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := make(map[string]attr.Value, len(values))
+	for key, value := range values {
+		elements[key] = types.StringValue(value)
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func TestAllTags(t *testing.T) {
+	r := &BareMetalServerResource{client: &ICSClient{DefaultTags: map[string]string{"team": "infra", "env": "prod"}}}
+
+	tests := map[string]struct {
+		tags types.Map
+		want types.Map
+	}{
+		"defaults only": {
+			tags: testStringMap(nil),
+			want: testStringMap(map[string]string{"team": "infra", "env": "prod"}),
+		},
+		"server tags added": {
+			tags: testStringMap(map[string]string{"role": "db"}),
+			want: testStringMap(map[string]string{"team": "infra", "env": "prod", "role": "db"}),
+		},
+		"server tags override defaults": {
+			tags: testStringMap(map[string]string{"env": "staging"}),
+			want: testStringMap(map[string]string{"team": "infra", "env": "staging"}),
+		},
+		"unknown tags": {
+			tags: types.MapUnknown(types.StringType),
+			want: types.MapUnknown(types.StringType),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := r.allTags(tt.tags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+
+	// Without default tags tags_all matches tags
+	got, _ := (&BareMetalServerResource{}).allTags(testStringMap(map[string]string{"role": "db"}))
+	if want := testStringMap(map[string]string{"role": "db"}); !got.Equal(want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestUpdateTagsFromServer(t *testing.T) {
+	r := &BareMetalServerResource{client: &ICSClient{DefaultTags: map[string]string{"team": "infra", "env": "prod"}}}
+
+	tests := map[string]struct {
+		tags       types.Map
+		serverTags map[string]string
+		wantTags   types.Map
+		wantAll    types.Map
+	}{
+		"inherited defaults stay out of tags": {
+			tags:       testStringMap(nil),
+			serverTags: map[string]string{"team": "infra", "env": "prod"},
+			wantTags:   testStringMap(nil),
+			wantAll:    testStringMap(map[string]string{"team": "infra", "env": "prod"}),
+		},
+		"server tag overrides a default": {
+			tags:       testStringMap(map[string]string{"env": "staging"}),
+			serverTags: map[string]string{"team": "infra", "env": "staging"},
+			wantTags:   testStringMap(map[string]string{"env": "staging"}),
+			wantAll:    testStringMap(map[string]string{"team": "infra", "env": "staging"}),
+		},
+		"configured tag equal to a default": {
+			tags:       testStringMap(map[string]string{"team": "infra"}),
+			serverTags: map[string]string{"team": "infra", "env": "prod"},
+			wantTags:   testStringMap(map[string]string{"team": "infra"}),
+			wantAll:    testStringMap(map[string]string{"team": "infra", "env": "prod"}),
+		},
+		"drift from a default": {
+			tags:       testStringMap(nil),
+			serverTags: map[string]string{"team": "web", "env": "prod"},
+			wantTags:   testStringMap(map[string]string{"team": "web"}),
+			wantAll:    testStringMap(map[string]string{"team": "web", "env": "prod"}),
+		},
+		"tag added outside terraform": {
+			tags:       testStringMap(map[string]string{"role": "db"}),
+			serverTags: map[string]string{"team": "infra", "env": "prod", "role": "db", "owner": "alice"},
+			wantTags:   testStringMap(map[string]string{"role": "db", "owner": "alice"}),
+			wantAll:    testStringMap(map[string]string{"team": "infra", "env": "prod", "role": "db", "owner": "alice"}),
+		},
+		"all tags removed": {
+			tags:       testStringMap(map[string]string{"role": "db"}),
+			serverTags: nil,
+			wantTags:   testStringMap(map[string]string{}),
+			wantAll:    testStringMap(map[string]string{}),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := BareMetalServerResourceModel{Tags: tt.tags}
+			r.updateTagsFromServer(&data, &Server{Tags: tt.serverTags})
+
+			if !data.Tags.Equal(tt.wantTags) {
+				t.Errorf("expected tags %s, got %s", tt.wantTags, data.Tags)
+			}
+			if !data.TagsAll.Equal(tt.wantAll) {
+				t.Errorf("expected tags_all %s, got %s", tt.wantAll, data.TagsAll)
+			}
+		})
+	}
+}
+
+func TestUpdateHostname(t *testing.T) {
+	var current string
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/rest-api/servers/abc123/hostname":
+			var request HostnameUpdateRequest
+			json.NewDecoder(r.Body).Decode(&request)
+			sent = append(sent, request.Hostname)
+			current = request.Hostname
+			w.Write([]byte(`{"statusCode":200,"message":"OK"}`))
+		case r.Method == "GET" && r.URL.Path == "/rest-api/servers":
+			fmt.Fprintf(w, `{"statusCode":200,"message":"OK","data":[{"id":"abc123","service_id":1001,"hostname":%q,"friendly_name":"Web"}]}`, current)
+		case r.Method == "GET" && r.URL.Path == "/rest-api/servers/abc123":
+			fmt.Fprintf(w, `{"statusCode":200,"message":"OK","data":{"id":"abc123","service_id":1001,"hostname":%q,"friendly_name":"Web"}}`, current)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &BareMetalServerResource{client: NewICSClient("token", server.URL)}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	// serverValue builds an existing server with the given hostname and every
+	// other optional attribute null
+	serverValue := func(hostname string) tftypes.Value {
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["id"] = tftypes.NewValue(tftypes.String, "abc123")
+		values["service_id"] = tftypes.NewValue(tftypes.Number, 1001)
+		values["instance_type"] = tftypes.NewValue(tftypes.String, "c1.small")
+		values["location"] = tftypes.NewValue(tftypes.String, "NYC1")
+		values["operating_system"] = tftypes.NewValue(tftypes.String, "Ubuntu 24.04")
+		values["friendly_name"] = tftypes.NewValue(tftypes.String, "Web")
+		values["hostname"] = tftypes.NewValue(tftypes.String, hostname)
+		return tftypes.NewValue(objectType, values)
+	}
+
+	tests := map[string]struct {
+		planned  string
+		wantSent []string
+	}{
+		"changed hostname is updated in place": {
+			planned:  "web-2.example.com",
+			wantSent: []string{"web-2.example.com"},
+		},
+		// hostname keeps its prior value in the plan once removed from
+		// configuration, so nothing is sent
+		"unchanged hostname is not sent": {
+			planned: "web-1.example.com",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			current, sent = "web-1.example.com", nil
+
+			req := resource.UpdateRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: serverValue("web-1.example.com")},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: serverValue(tt.planned)},
+			}
+			resp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+			r.Update(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if len(sent) != len(tt.wantSent) || (len(sent) > 0 && sent[0] != tt.wantSent[0]) {
+				t.Errorf("expected hostname updates %v, got %v", tt.wantSent, sent)
+			}
+
+			var hostname types.String
+			resp.State.GetAttribute(context.Background(), path.Root("hostname"), &hostname)
+			if hostname.ValueString() != tt.planned {
+				t.Errorf("expected hostname %q in state, got %s", tt.planned, hostname)
+			}
+		})
+	}
+}
+
+func TestUpdateModelFromServerRootPassword(t *testing.T) {
+	tests := map[string]struct {
+		storeRootPassword types.Bool
+		want              types.String
+	}{
+		"default stores the password": {types.BoolNull(), types.StringValue("s3cret")},
+		"stored":                      {types.BoolValue(true), types.StringValue("s3cret")},
+		"not stored":                  {types.BoolValue(false), types.StringNull()},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := BareMetalServerResourceModel{StoreRootPassword: tt.storeRootPassword}
+			(&BareMetalServerResource{}).updateModelFromServer(&data, &Server{ID: "abc123", RootPassword: "s3cret"})
+
+			if !data.RootPassword.Equal(tt.want) {
+				t.Errorf("expected root_password %s, got %s", tt.want, data.RootPassword)
+			}
+		})
+	}
+}
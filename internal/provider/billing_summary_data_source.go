@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BillingSummaryDataSource{}
+
+func NewBillingSummaryDataSource() datasource.DataSource {
+	return &BillingSummaryDataSource{}
+}
+
+// BillingSummaryDataSource defines the data source implementation.
+type BillingSummaryDataSource struct {
+	client *ICSClient
+}
+
+// BillingSummaryDataSourceModel describes the data source data model.
+type BillingSummaryDataSourceModel struct {
+	ID                  types.String          `tfsdk:"id"`
+	PeriodStart         types.String          `tfsdk:"period_start"`
+	PeriodEnd           types.String          `tfsdk:"period_end"`
+	CurrencyCode        types.String          `tfsdk:"currency_code"`
+	TotalAmount         types.Number          `tfsdk:"total_amount"`
+	HourlyAccruedAmount types.Number          `tfsdk:"hourly_accrued_amount"`
+	Services            []BillingServiceModel `tfsdk:"services"`
+}
+
+type BillingServiceModel struct {
+	ServiceID    types.Int64   `tfsdk:"service_id"`
+	Description  types.String  `tfsdk:"description"`
+	Amount       types.Number  `tfsdk:"amount"`
+	BillHourly   types.Bool    `tfsdk:"bill_hourly"`
+	HoursAccrued types.Float64 `tfsdk:"hours_accrued"`
+	HourlyRate   types.Number  `tfsdk:"hourly_rate"`
+}
+
+func (d *BillingSummaryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_billing_summary"
+}
+
+func (d *BillingSummaryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Billing summary data source provides the account's charges for the current billing period, grouped by service.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"period_start": schema.StringAttribute{
+				MarkdownDescription: "Start of the current billing period (RFC3339)",
+				Computed:            true,
+			},
+			"period_end": schema.StringAttribute{
+				MarkdownDescription: "End of the current billing period (RFC3339)",
+				Computed:            true,
+			},
+			"currency_code": schema.StringAttribute{
+				MarkdownDescription: "Currency of all amounts (e.g., 'USD')",
+				Computed:            true,
+			},
+			"total_amount": schema.NumberAttribute{
+				MarkdownDescription: "Total charges for the billing period so far",
+				Computed:            true,
+			},
+			"hourly_accrued_amount": schema.NumberAttribute{
+				MarkdownDescription: "Charges accrued so far by hourly-billed services",
+				Computed:            true,
+			},
+			"services": schema.ListNestedAttribute{
+				MarkdownDescription: "Charges per service",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service_id": schema.Int64Attribute{
+							MarkdownDescription: "Service identifier (the `service_id` of an `ics_bare_metal_server`)",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Service description",
+							Computed:            true,
+						},
+						"amount": schema.NumberAttribute{
+							MarkdownDescription: "Charges for the service in the billing period so far",
+							Computed:            true,
+						},
+						"bill_hourly": schema.BoolAttribute{
+							MarkdownDescription: "Whether the service is billed hourly",
+							Computed:            true,
+						},
+						"hours_accrued": schema.Float64Attribute{
+							MarkdownDescription: "Hours billed so far. Null for monthly services.",
+							Computed:            true,
+						},
+						"hourly_rate": schema.NumberAttribute{
+							MarkdownDescription: "Price per hour. Null for monthly services.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BillingSummaryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BillingSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BillingSummaryDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get billing summary from API
+	summary, err := d.client.GetBillingSummary(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read billing summary, got error: %s", err))
+		return
+	}
+
+	// Convert API response to Terraform model. Amounts are summed at the
+	// precision they were parsed with so decimal cents add up exactly.
+	hourlyAccrued := new(big.Float).SetPrec(512)
+	services := []BillingServiceModel{}
+	for _, service := range summary.Services {
+		model := BillingServiceModel{
+			ServiceID:    types.Int64Value(int64(service.ServiceID)),
+			Description:  types.StringValue(service.Description),
+			Amount:       types.NumberValue(service.Amount.Amount),
+			BillHourly:   types.BoolValue(service.BillHourly),
+			HoursAccrued: types.Float64Null(),
+			HourlyRate:   types.NumberNull(),
+		}
+		if service.BillHourly {
+			model.HoursAccrued = types.Float64Value(service.HoursAccrued)
+			model.HourlyRate = types.NumberValue(service.HourlyRate.Amount)
+			if service.Amount.Amount != nil {
+				hourlyAccrued.Add(hourlyAccrued, service.Amount.Amount)
+			}
+		}
+		services = append(services, model)
+	}
+
+	data.ID = types.StringValue("billing_summary")
+	data.PeriodStart = types.StringValue(summary.PeriodStart)
+	data.PeriodEnd = types.StringValue(summary.PeriodEnd)
+	data.CurrencyCode = types.StringValue(summary.CurrencyCode)
+	data.TotalAmount = types.NumberValue(summary.TotalAmount.Amount)
+	data.HourlyAccruedAmount = types.NumberValue(hourlyAccrued)
+	data.Services = services
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestBillingSummaryRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest-api/billing/summary" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"statusCode":200,"message":"OK","data":{
+			"period_start":"2024-06-01T00:00:00Z",
+			"period_end":"2024-07-01T00:00:00Z",
+			"currency_code":"USD",
+			"total_amount":"1234.30",
+			"services":[
+				{"service_id":1,"description":"Monthly server","amount":1234.00,"bill_hourly":false},
+				{"service_id":2,"description":"Hourly server","amount":0.1,"bill_hourly":true,"hours_accrued":1,"hourly_rate":0.1},
+				{"service_id":3,"description":"Hourly server","amount":"0.2","bill_hourly":true,"hours_accrued":2,"hourly_rate":"0.1"}
+			]}}`))
+	}))
+	defer server.Close()
+
+	d := &BillingSummaryDataSource{client: NewICSClient("token", server.URL)}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for key, attrType := range objectType.AttributeTypes {
+		values[key] = tftypes.NewValue(attrType, nil)
+	}
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	d.Read(context.Background(), datasource.ReadRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data BillingSummaryDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	// Decimal amounts are exact, so 0.1 + 0.2 is 0.3
+	for name, tt := range map[string]struct {
+		got  *big.Float
+		want string
+	}{
+		"total_amount":          {data.TotalAmount.ValueBigFloat(), "1234.3"},
+		"hourly_accrued_amount": {data.HourlyAccruedAmount.ValueBigFloat(), "0.3"},
+		"amount":                {data.Services[0].Amount.ValueBigFloat(), "1234"},
+		"hourly_rate":           {data.Services[2].HourlyRate.ValueBigFloat(), "0.1"},
+	} {
+		want, _, _ := big.ParseFloat(tt.want, 10, 512, big.ToNearestEven)
+		if tt.got == nil || tt.got.Cmp(want) != 0 {
+			t.Errorf("%s: expected %s, got %v", name, tt.want, tt.got)
+		}
+	}
+
+	if !data.Services[0].HourlyRate.IsNull() || !data.Services[0].HoursAccrued.IsNull() {
+		t.Errorf("expected null hourly attributes for a monthly service")
+	}
+}
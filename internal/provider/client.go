@@ -2,18 +2,52 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
+	"net/url"
+	"sync"
 	"time"
 )
 
+// serverListCacheTTL is how long a server list is shared between lookups by
+// service ID, so refreshing many servers in one plan costs a single list call.
+const serverListCacheTTL = 10 * time.Second
+
+// ErrNotFound is wrapped by client errors for objects the API reports as missing
+var ErrNotFound = errors.New("not found")
+
+// ErrUnauthorized is wrapped by client errors when the API rejects the API token
+var ErrUnauthorized = errors.New("unauthorized")
+
 // ICSClient is the API client for Ingenuity Cloud Services
 type ICSClient struct {
 	APIToken   string
 	BaseURL    string
 	HTTPClient *http.Client
+
+	// UserAgent is sent with every request when set
+	UserAgent string
+
+	// LogBodies includes request and response bodies in trace logs. Set from
+	// the provider configuration.
+	LogBodies bool
+
+	// WarnOnServerReplacement makes resources emit a plan warning whenever a
+	// bare metal server would be replaced. Set from the provider configuration.
+	WarnOnServerReplacement bool
+
+	// DefaultTags are merged into the tags of every bare metal server. Set from
+	// the provider configuration.
+	DefaultTags map[string]string
+
+	serversMu        sync.Mutex
+	serversCache     []Server
+	serversFetchedAt time.Time
 }
 
 // APIResponse represents the standard API response format
@@ -47,11 +81,51 @@ type InventoryItem struct {
 	Metadata              []InventoryMetadata    `json:"metadata"`
 	CurrencyCode          string                 `json:"currency_code"`
 	SkuProductName        string                 `json:"sku_product_name"`
-	Price                 string                 `json:"price"`
-	PriceHourly           string                 `json:"price_hourly"`
+	Price                 Price                  `json:"price"`
+	PriceHourly           Price                  `json:"price_hourly"`
 	HourlyEnabled         bool                   `json:"hourly_enabled"`
 }
 
+// Price is a decimal amount the API encodes either as a JSON number or as a
+// string such as "99.00" or "$1,299.00"
+type Price struct {
+	// Text is the price as returned by the API
+	Text string
+	// Amount is nil if the price is empty or could not be parsed
+	Amount *big.Float
+}
+
+// UnmarshalJSON accepts numbers, strings and null
+func (p *Price) UnmarshalJSON(data []byte) error {
+	*p = Price{}
+
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+
+		// Strings may be formatted, but must reduce to a plain decimal
+		p.Text = text
+		p.Amount, _ = parsePrice(text)
+		return nil
+	}
+	p.Text = string(data)
+
+	// Parse at the precision Terraform uses for numbers so decimal prices are
+	// not rounded through float64
+	amount, _, err := big.ParseFloat(p.Text, 10, 512, big.ToNearestEven)
+	if err == nil {
+		p.Amount = amount
+	}
+
+	return nil
+}
+
 // InventoryMetadata represents metadata for an inventory item
 type InventoryMetadata struct {
 	Name        string `json:"name"`
@@ -76,6 +150,25 @@ type Server struct {
 	ServerType         string `json:"server_type"`
 	BillHourly         bool   `json:"bill_hourly"`
 	RootPassword       string `json:"root_password"`
+	SkuProductName     string `json:"sku_product_name"`
+	LocationCode       string `json:"location_code"`
+	OperatingSystem    string `json:"operating_system"`
+	Tags               map[string]string `json:"tags"`
+	IPAddresses        []ServerIPAddress `json:"ip_addresses"`
+	PrivateNetworks    []ServerPrivateNetwork `json:"private_networks"`
+}
+
+// ServerIPAddress represents an IP address routed to a server
+type ServerIPAddress struct {
+	Address string `json:"address"`
+	Version int    `json:"version"`
+	Primary bool   `json:"primary"`
+}
+
+// ServerPrivateNetwork represents a server's address on a private network
+type ServerPrivateNetwork struct {
+	NetworkID int    `json:"network_id"`
+	PrivateIP string `json:"private_ip"`
 }
 
 // ServerOrderRequest represents a server order request
@@ -87,6 +180,7 @@ type ServerOrderRequest struct {
 	Hostname                    string   `json:"hostname,omitempty"`
 	BillHourly                  bool     `json:"bill_hourly,omitempty"`
 	SSHKeyIDs                   []int    `json:"ssh_key_ids,omitempty"`
+	UserData                    string   `json:"user_data,omitempty"` // Base64-encoded cloud-init user data
 }
 
 // ServerOrderResponse represents the response from ordering a server
@@ -184,6 +278,183 @@ type FriendlyNameUpdateRequest struct {
 	FriendlyName string `json:"friendly_name"`
 }
 
+// ServerSSHKeyRequest represents a request to assign an SSH key to a server
+type ServerSSHKeyRequest struct {
+	SSHKeyID int `json:"ssh_key_id"`
+}
+
+// RootPasswordResetResponse represents the response from resetting a server root password
+type RootPasswordResetResponse struct {
+	RootPassword string `json:"root_password"`
+}
+
+// ServerTagsUpdateRequest represents a request to replace the tags of a server
+type ServerTagsUpdateRequest struct {
+	Tags map[string]string `json:"tags"`
+}
+
+// ReverseDNS represents the PTR record of an IP address
+type ReverseDNS struct {
+	IPAddress string `json:"ip_address"`
+	Hostname  string `json:"hostname"`
+}
+
+// ReverseDNSUpdateRequest represents a request to set the PTR record of an IP address
+type ReverseDNSUpdateRequest struct {
+	Hostname string `json:"hostname"`
+}
+
+// IPAllocation represents additional IP addresses or a subnet allocated to a server or location
+type IPAllocation struct {
+	ID           int      `json:"id"`
+	ServerID     string   `json:"server_id"`
+	LocationCode string   `json:"location_code"`
+	Type         string   `json:"type"`
+	PrefixLength int      `json:"prefix_length"`
+	CIDR         string   `json:"cidr"`
+	Addresses    []string `json:"addresses"`
+	Gateway      string   `json:"gateway"`
+	Netmask      string   `json:"netmask"`
+	Status       string   `json:"status"`
+}
+
+// IPAllocationOrderRequest represents a request to allocate additional IP addresses
+type IPAllocationOrderRequest struct {
+	ServerID     string `json:"server_id,omitempty"`
+	LocationCode string `json:"location_code,omitempty"`
+	Type         string `json:"type"`          // Required: "ipv4" or "ipv6"
+	PrefixLength int    `json:"prefix_length"` // Required: e.g. 32 for a single IPv4 address
+}
+
+// PrivateNetwork represents a private VLAN within a datacenter
+type PrivateNetwork struct {
+	ID             int                        `json:"id"`
+	Name           string                     `json:"name"`
+	DatacenterID   int                        `json:"datacenter_id"`
+	DatacenterName string                     `json:"datacenter_name"`
+	VlanID         int                        `json:"vlan_id"`
+	CIDR           string                     `json:"cidr"`
+	Attachments    []PrivateNetworkAttachment `json:"servers"`
+}
+
+// PrivateNetworkAttachment represents a server attached to a private network
+type PrivateNetworkAttachment struct {
+	ServerID  string `json:"server_id"`
+	PrivateIP string `json:"private_ip"`
+}
+
+// PrivateNetworkRequest represents a request to create or rename a private network
+type PrivateNetworkRequest struct {
+	Name         string `json:"name"`
+	DatacenterID int    `json:"datacenter_id,omitempty"`
+	CIDR         string `json:"cidr,omitempty"`
+}
+
+// PrivateNetworkAttachRequest represents a request to attach a server to a private network
+type PrivateNetworkAttachRequest struct {
+	ServerID  string `json:"server_id"`
+	PrivateIP string `json:"private_ip,omitempty"` // Assigned automatically if empty
+}
+
+// Firewall represents an ordered set of firewall rules applied to servers
+type Firewall struct {
+	ID        int            `json:"id"`
+	Name      string         `json:"name"`
+	Rules     []FirewallRule `json:"rules"`
+	ServerIDs []string       `json:"server_ids"`
+}
+
+// FirewallRule represents a single firewall rule. Rules are evaluated in order.
+type FirewallRule struct {
+	Direction   string `json:"direction"`            // "ingress" or "egress"
+	Protocol    string `json:"protocol"`             // "tcp", "udp", "icmp" or "any"
+	PortRange   string `json:"port_range,omitempty"` // e.g. "22" or "8000-8100"; all ports if empty
+	CIDR        string `json:"cidr,omitempty"`       // Source for ingress, destination for egress; any if empty
+	Action      string `json:"action"`               // "accept" or "drop"
+	Description string `json:"description,omitempty"`
+}
+
+// FirewallRequest represents a request to create a firewall or replace its rules
+type FirewallRequest struct {
+	Name  string         `json:"name"`
+	Rules []FirewallRule `json:"rules"`
+}
+
+// FirewallServersRequest represents a request to set the servers a firewall applies to
+type FirewallServersRequest struct {
+	ServerIDs []string `json:"server_ids"`
+}
+
+// ConsoleAccessRequest represents a request for temporary out-of-band console credentials
+type ConsoleAccessRequest struct {
+	SourceIP        string `json:"source_ip"`        // Only this address may connect to the console
+	DurationMinutes int    `json:"duration_minutes"` // How long the credentials stay valid
+}
+
+// ConsoleAccess represents temporary IPMI/KVM console credentials for a server
+type ConsoleAccess struct {
+	Host       string `json:"host"`
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	ConsoleURL string `json:"console_url"`
+	ExpiresAt  string `json:"expires_at"` // RFC3339
+}
+
+// BandwidthUsage represents a server's traffic over a time window
+type BandwidthUsage struct {
+	ServerID           string  `json:"server_id"`
+	PeriodStart        string  `json:"period_start"` // RFC3339
+	PeriodEnd          string  `json:"period_end"`   // RFC3339
+	InboundBytes       int64   `json:"inbound_bytes"`
+	OutboundBytes      int64   `json:"outbound_bytes"`
+	Percentile95thMbps float64 `json:"percentile_95th_mbps"`
+	AllowanceBytes     int64   `json:"allowance_bytes"` // 0 if the server is unmetered
+}
+
+// BillingSummary represents the account's charges for the current billing period
+type BillingSummary struct {
+	PeriodStart  string                 `json:"period_start"` // RFC3339
+	PeriodEnd    string                 `json:"period_end"`   // RFC3339
+	CurrencyCode string                 `json:"currency_code"`
+	TotalAmount  Price                  `json:"total_amount"`
+	Services     []BillingServiceCharge `json:"services"`
+}
+
+// BillingServiceCharge represents the charges for a single service in the billing period
+type BillingServiceCharge struct {
+	ServiceID    int     `json:"service_id"`
+	Description  string  `json:"description"`
+	Amount       Price   `json:"amount"`
+	BillHourly   bool    `json:"bill_hourly"`
+	HoursAccrued float64 `json:"hours_accrued"` // Hourly services only
+	HourlyRate   Price   `json:"hourly_rate"`   // Hourly services only
+}
+
+// Location represents a location (datacenter) servers can be ordered in
+type Location struct {
+	Code           string `json:"location_code"`
+	DatacenterID   int    `json:"datacenter_id"`
+	DatacenterName string `json:"datacenter_name"`
+	RegionID       int    `json:"region_id"`
+	Country        string `json:"country"`
+	City           string `json:"city"`
+}
+
+// Account represents the account the API token belongs to
+type Account struct {
+	ID           int      `json:"id"`
+	Name         string   `json:"name"`
+	Email        string   `json:"email"`
+	Balance      Price    `json:"balance"`
+	CurrencyCode string   `json:"currency_code"`
+	Permissions  []string `json:"permissions"`
+}
+
+// HostnameUpdateRequest represents a request to update server hostname
+type HostnameUpdateRequest struct {
+	Hostname string `json:"hostname"`
+}
+
 // NewICSClient creates a new ICS API client
 func NewICSClient(apiToken, baseURL string) *ICSClient {
 	return &ICSClient{
@@ -196,35 +467,68 @@ func NewICSClient(apiToken, baseURL string) *ICSClient {
 }
 
 // makeRequest makes an HTTP request to the ICS API
-func (c *ICSClient) makeRequest(method, endpoint string, body interface{}) (*http.Response, error) {
+func (c *ICSClient) makeRequest(ctx context.Context, method, endpoint string, body interface{}) (*http.Response, error) {
 	url := fmt.Sprintf("%s%s", c.BaseURL, endpoint)
 
 	var reqBody io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
-	req, err := http.NewRequest(method, url, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("X-Api-Token", c.APIToken)
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	return c.HTTPClient.Do(req)
+	// Log with the caller's context so entries carry its request ID
+	logCtx := c.logContext(ctx)
+	c.logRequest(logCtx, req, jsonBody)
+	start := time.Now()
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		c.logResponse(logCtx, req, nil, nil, time.Since(start), err)
+		return nil, err
+	}
+
+	// Buffer the body so it can be logged and still read by the caller
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		c.logResponse(logCtx, req, nil, nil, time.Since(start), err)
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	c.logResponse(logCtx, req, resp, respBody, time.Since(start), nil)
+
+	// Any endpoint can reject the token, so callers can check for this with
+	// errors.Is instead of each handling the status codes
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, fmt.Errorf("%w: API request failed with status %d: %s", ErrUnauthorized, resp.StatusCode, string(respBody))
+	}
+
+	return resp, nil
 }
 
 // GetInventory retrieves the server inventory
-func (c *ICSClient) GetInventory() ([]InventoryItem, error) {
-	resp, err := c.makeRequest("GET", "/rest-api/server-orders/inventory", nil)
+func (c *ICSClient) GetInventory(ctx context.Context) ([]InventoryItem, error) {
+	resp, err := c.makeRequest(ctx, "GET", "/rest-api/server-orders/inventory", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get inventory: %w", err)
 	}
@@ -239,8 +543,11 @@ func (c *ICSClient) GetInventory() ([]InventoryItem, error) {
 		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
 	}
 
+	// Keep numbers as written so prices are not rounded through float64
 	var apiResp APIResponse
-	if err := json.Unmarshal(body, &apiResp); err != nil {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&apiResp); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -259,12 +566,13 @@ func (c *ICSClient) GetInventory() ([]InventoryItem, error) {
 }
 
 // OrderServer orders a new bare metal server
-func (c *ICSClient) OrderServer(request ServerOrderRequest) (*ServerOrderResponse, error) {
-	resp, err := c.makeRequest("POST", "/rest-api/server-orders/order", request)
+func (c *ICSClient) OrderServer(ctx context.Context, request ServerOrderRequest) (*ServerOrderResponse, error) {
+	resp, err := c.makeRequest(ctx, "POST", "/rest-api/server-orders/order", request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to order server: %w", err)
 	}
 	defer resp.Body.Close()
+	defer c.invalidateServersCache()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -295,8 +603,8 @@ func (c *ICSClient) OrderServer(request ServerOrderRequest) (*ServerOrderRespons
 }
 
 // GetServers retrieves all servers
-func (c *ICSClient) GetServers() ([]Server, error) {
-	resp, err := c.makeRequest("GET", "/rest-api/servers", nil)
+func (c *ICSClient) GetServers(ctx context.Context) ([]Server, error) {
+	resp, err := c.makeRequest(ctx, "GET", "/rest-api/servers", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get servers: %w", err)
 	}
@@ -330,9 +638,52 @@ func (c *ICSClient) GetServers() ([]Server, error) {
 	return servers, nil
 }
 
-// GetServerByServiceID retrieves a server by its service ID
-func (c *ICSClient) GetServerByServiceID(serviceID int) (*Server, error) {
-	servers, err := c.GetServers()
+// GetServer retrieves a single server by its server ID
+func (c *ICSClient) GetServer(ctx context.Context, serverID string) (*Server, error) {
+	endpoint := fmt.Sprintf("/rest-api/servers/%s", serverID)
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("server with ID %s %w", serverID, ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to Server
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var server Server
+	if err := json.Unmarshal(dataBytes, &server); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal server data: %w", err)
+	}
+
+	return &server, nil
+}
+
+// GetServerByServiceID retrieves a server by its service ID. Only the server
+// list is searchable by service ID, so the list is shared between calls made
+// within serverListCacheTTL.
+func (c *ICSClient) GetServerByServiceID(ctx context.Context, serviceID int) (*Server, error) {
+	servers, err := c.getServersCached(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -343,17 +694,48 @@ func (c *ICSClient) GetServerByServiceID(serviceID int) (*Server, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("server with service ID %d not found", serviceID)
+	return nil, fmt.Errorf("server with service ID %d %w", serviceID, ErrNotFound)
+}
+
+// getServersCached returns the server list, fetching it at most once per
+// serverListCacheTTL. Concurrent callers wait for and share the same fetch.
+func (c *ICSClient) getServersCached(ctx context.Context) ([]Server, error) {
+	c.serversMu.Lock()
+	defer c.serversMu.Unlock()
+
+	if c.serversCache != nil && time.Since(c.serversFetchedAt) < serverListCacheTTL {
+		return c.serversCache, nil
+	}
+
+	servers, err := c.GetServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.serversCache = servers
+	c.serversFetchedAt = time.Now()
+
+	return servers, nil
+}
+
+// invalidateServersCache drops the shared server list after changes to the set of servers
+// or the addresses routed to them
+func (c *ICSClient) invalidateServersCache() {
+	c.serversMu.Lock()
+	defer c.serversMu.Unlock()
+
+	c.serversCache = nil
 }
 
 // CancelServer cancels/deletes a server (hourly billed servers only)
-func (c *ICSClient) CancelServer(serverID string) error {
+func (c *ICSClient) CancelServer(ctx context.Context, serverID string) error {
 	endpoint := fmt.Sprintf("/rest-api/servers/%s/cancel", serverID)
-	resp, err := c.makeRequest("DELETE", endpoint, nil)
+	resp, err := c.makeRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return fmt.Errorf("failed to cancel server: %w", err)
 	}
 	defer resp.Body.Close()
+	defer c.invalidateServersCache()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -368,9 +750,9 @@ func (c *ICSClient) CancelServer(serverID string) error {
 }
 
 // GetAddons retrieves available addons for a specific SKU and location
-func (c *ICSClient) GetAddons(skuProductName, locationCode string) (*AddonsResponse, error) {
+func (c *ICSClient) GetAddons(ctx context.Context, skuProductName, locationCode string) (*AddonsResponse, error) {
 	endpoint := fmt.Sprintf("/rest-api/server-orders/list-addons?sku_product_name=%s&location_code=%s", skuProductName, locationCode)
-	resp, err := c.makeRequest("GET", endpoint, nil)
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get addons: %w", err)
 	}
@@ -405,8 +787,8 @@ func (c *ICSClient) GetAddons(skuProductName, locationCode string) (*AddonsRespo
 }
 
 // FindSKUByProductName finds a SKU by its product name and validates inventory
-func (c *ICSClient) FindSKUByProductName(productName, locationCode string) (*InventoryItem, error) {
-	inventory, err := c.GetInventory()
+func (c *ICSClient) FindSKUByProductName(ctx context.Context, productName, locationCode string) (*InventoryItem, error) {
+	inventory, err := c.GetInventory(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get inventory: %w", err)
 	}
@@ -431,8 +813,8 @@ func (c *ICSClient) FindSKUByProductName(productName, locationCode string) (*Inv
 }
 
 // GetOperatingSystemByName finds an operating system by name for a specific SKU and location
-func (c *ICSClient) GetOperatingSystemByName(skuProductName, locationCode, osName string) (*OperatingSystemItem, error) {
-	addons, err := c.GetAddons(skuProductName, locationCode)
+func (c *ICSClient) GetOperatingSystemByName(ctx context.Context, skuProductName, locationCode, osName string) (*OperatingSystemItem, error) {
+	addons, err := c.GetAddons(ctx, skuProductName, locationCode)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get addons: %w", err)
 	}
@@ -447,8 +829,8 @@ func (c *ICSClient) GetOperatingSystemByName(skuProductName, locationCode, osNam
 }
 
 // CreateSSHKey creates a new SSH key
-func (c *ICSClient) CreateSSHKey(request SSHKeyCreateRequest) (*SSHKeyCreateResponse, error) {
-	resp, err := c.makeRequest("POST", "/rest-api/ssh-keys", request)
+func (c *ICSClient) CreateSSHKey(ctx context.Context, request SSHKeyCreateRequest) (*SSHKeyCreateResponse, error) {
+	resp, err := c.makeRequest(ctx, "POST", "/rest-api/ssh-keys", request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create SSH key: %w", err)
 	}
@@ -483,8 +865,8 @@ func (c *ICSClient) CreateSSHKey(request SSHKeyCreateRequest) (*SSHKeyCreateResp
 }
 
 // GetSSHKeys retrieves all SSH keys
-func (c *ICSClient) GetSSHKeys() ([]SSHKey, error) {
-	resp, err := c.makeRequest("GET", "/rest-api/ssh-keys", nil)
+func (c *ICSClient) GetSSHKeys(ctx context.Context) ([]SSHKey, error) {
+	resp, err := c.makeRequest(ctx, "GET", "/rest-api/ssh-keys", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get SSH keys: %w", err)
 	}
@@ -519,8 +901,8 @@ func (c *ICSClient) GetSSHKeys() ([]SSHKey, error) {
 }
 
 // GetSSHKeyByLabel finds an SSH key by its label
-func (c *ICSClient) GetSSHKeyByLabel(label string) (*SSHKey, error) {
-	sshKeys, err := c.GetSSHKeys()
+func (c *ICSClient) GetSSHKeyByLabel(ctx context.Context, label string) (*SSHKey, error) {
+	sshKeys, err := c.GetSSHKeys(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get SSH keys: %w", err)
 	}
@@ -535,9 +917,9 @@ func (c *ICSClient) GetSSHKeyByLabel(label string) (*SSHKey, error) {
 }
 
 // DeleteSSHKey deletes an SSH key by ID
-func (c *ICSClient) DeleteSSHKey(keyID int) error {
+func (c *ICSClient) DeleteSSHKey(ctx context.Context, keyID int) error {
 	endpoint := fmt.Sprintf("/rest-api/ssh-keys/%d", keyID)
-	resp, err := c.makeRequest("DELETE", endpoint, nil)
+	resp, err := c.makeRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return fmt.Errorf("failed to delete SSH key: %w", err)
 	}
@@ -556,14 +938,14 @@ func (c *ICSClient) DeleteSSHKey(keyID int) error {
 }
 
 // UpdateServerFriendlyName updates the friendly name of a server
-func (c *ICSClient) UpdateServerFriendlyName(serverID, friendlyName string) error {
+func (c *ICSClient) UpdateServerFriendlyName(ctx context.Context, serverID, friendlyName string) error {
 	endpoint := fmt.Sprintf("/rest-api/servers/%s/friendly-name", serverID)
 
 	request := FriendlyNameUpdateRequest{
 		FriendlyName: friendlyName,
 	}
 
-	resp, err := c.makeRequest("PUT", endpoint, request)
+	resp, err := c.makeRequest(ctx, "PUT", endpoint, request)
 	if err != nil {
 		return fmt.Errorf("failed to update server friendly name: %w", err)
 	}
@@ -579,4 +961,877 @@ func (c *ICSClient) UpdateServerFriendlyName(serverID, friendlyName string) erro
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// UpdateServerHostname updates the hostname of a server
+func (c *ICSClient) UpdateServerHostname(ctx context.Context, serverID, hostname string) error {
+	endpoint := fmt.Sprintf("/rest-api/servers/%s/hostname", serverID)
+
+	request := HostnameUpdateRequest{
+		Hostname: hostname,
+	}
+
+	resp, err := c.makeRequest(ctx, "PUT", endpoint, request)
+	if err != nil {
+		return fmt.Errorf("failed to update server hostname: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// AssignSSHKeyToServer adds an SSH key to an existing server
+func (c *ICSClient) AssignSSHKeyToServer(ctx context.Context, serverID string, keyID int) error {
+	endpoint := fmt.Sprintf("/rest-api/servers/%s/ssh-keys", serverID)
+
+	request := ServerSSHKeyRequest{
+		SSHKeyID: keyID,
+	}
+
+	resp, err := c.makeRequest(ctx, "POST", endpoint, request)
+	if err != nil {
+		return fmt.Errorf("failed to assign SSH key to server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// RemoveSSHKeyFromServer removes an SSH key from an existing server
+func (c *ICSClient) RemoveSSHKeyFromServer(ctx context.Context, serverID string, keyID int) error {
+	endpoint := fmt.Sprintf("/rest-api/servers/%s/ssh-keys/%d", serverID, keyID)
+	resp, err := c.makeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to remove SSH key from server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// ResetServerRootPassword generates a new root password for a server
+func (c *ICSClient) ResetServerRootPassword(ctx context.Context, serverID string) (*RootPasswordResetResponse, error) {
+	endpoint := fmt.Sprintf("/rest-api/servers/%s/reset-password", serverID)
+	resp, err := c.makeRequest(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset server root password: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to RootPasswordResetResponse
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var resetResp RootPasswordResetResponse
+	if err := json.Unmarshal(dataBytes, &resetResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal root password reset response data: %w", err)
+	}
+
+	return &resetResp, nil
+}
+
+// SetServerTags replaces all tags of a server
+func (c *ICSClient) SetServerTags(ctx context.Context, serverID string, tags map[string]string) error {
+	endpoint := fmt.Sprintf("/rest-api/servers/%s/tags", serverID)
+
+	if tags == nil {
+		tags = map[string]string{}
+	}
+
+	request := ServerTagsUpdateRequest{
+		Tags: tags,
+	}
+
+	resp, err := c.makeRequest(ctx, "PUT", endpoint, request)
+	if err != nil {
+		return fmt.Errorf("failed to update server tags: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// GetReverseDNS retrieves the PTR record of an IP address
+func (c *ICSClient) GetReverseDNS(ctx context.Context, ipAddress string) (*ReverseDNS, error) {
+	endpoint := fmt.Sprintf("/rest-api/reverse-dns/%s", url.PathEscape(ipAddress))
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reverse DNS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("reverse DNS for %s %w", ipAddress, ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to ReverseDNS
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var reverseDNS ReverseDNS
+	if err := json.Unmarshal(dataBytes, &reverseDNS); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal reverse DNS data: %w", err)
+	}
+
+	return &reverseDNS, nil
+}
+
+// SetReverseDNS sets the PTR record of an IP address
+func (c *ICSClient) SetReverseDNS(ctx context.Context, ipAddress, hostname string) error {
+	endpoint := fmt.Sprintf("/rest-api/reverse-dns/%s", url.PathEscape(ipAddress))
+
+	request := ReverseDNSUpdateRequest{
+		Hostname: hostname,
+	}
+
+	resp, err := c.makeRequest(ctx, "PUT", endpoint, request)
+	if err != nil {
+		return fmt.Errorf("failed to set reverse DNS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DeleteReverseDNS resets the PTR record of an IP address to the default
+func (c *ICSClient) DeleteReverseDNS(ctx context.Context, ipAddress string) error {
+	endpoint := fmt.Sprintf("/rest-api/reverse-dns/%s", url.PathEscape(ipAddress))
+	resp, err := c.makeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete reverse DNS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// OrderIPAllocation orders additional IP addresses or a subnet
+func (c *ICSClient) OrderIPAllocation(ctx context.Context, request IPAllocationOrderRequest) (*IPAllocation, error) {
+	resp, err := c.makeRequest(ctx, "POST", "/rest-api/ip-allocations", request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to order IP allocation: %w", err)
+	}
+	defer resp.Body.Close()
+	defer c.invalidateServersCache()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to IPAllocation
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var allocation IPAllocation
+	if err := json.Unmarshal(dataBytes, &allocation); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal IP allocation data: %w", err)
+	}
+
+	return &allocation, nil
+}
+
+// GetIPAllocation retrieves an IP allocation by ID
+func (c *ICSClient) GetIPAllocation(ctx context.Context, allocationID int) (*IPAllocation, error) {
+	endpoint := fmt.Sprintf("/rest-api/ip-allocations/%d", allocationID)
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IP allocation: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("IP allocation %d %w", allocationID, ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to IPAllocation
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var allocation IPAllocation
+	if err := json.Unmarshal(dataBytes, &allocation); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal IP allocation data: %w", err)
+	}
+
+	return &allocation, nil
+}
+
+// CancelIPAllocation cancels an IP allocation and releases its addresses
+func (c *ICSClient) CancelIPAllocation(ctx context.Context, allocationID int) error {
+	endpoint := fmt.Sprintf("/rest-api/ip-allocations/%d", allocationID)
+	resp, err := c.makeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to cancel IP allocation: %w", err)
+	}
+	defer resp.Body.Close()
+	defer c.invalidateServersCache()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// CreatePrivateNetwork creates a private network in a datacenter
+func (c *ICSClient) CreatePrivateNetwork(ctx context.Context, request PrivateNetworkRequest) (*PrivateNetwork, error) {
+	resp, err := c.makeRequest(ctx, "POST", "/rest-api/private-networks", request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create private network: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return unmarshalPrivateNetwork(body)
+}
+
+// GetPrivateNetwork retrieves a private network and its attached servers by ID
+func (c *ICSClient) GetPrivateNetwork(ctx context.Context, networkID int) (*PrivateNetwork, error) {
+	endpoint := fmt.Sprintf("/rest-api/private-networks/%d", networkID)
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get private network: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("private network %d %w", networkID, ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return unmarshalPrivateNetwork(body)
+}
+
+// RenamePrivateNetwork changes the name of a private network
+func (c *ICSClient) RenamePrivateNetwork(ctx context.Context, networkID int, name string) error {
+	endpoint := fmt.Sprintf("/rest-api/private-networks/%d", networkID)
+
+	request := PrivateNetworkRequest{
+		Name: name,
+	}
+
+	resp, err := c.makeRequest(ctx, "PUT", endpoint, request)
+	if err != nil {
+		return fmt.Errorf("failed to rename private network: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DeletePrivateNetwork deletes a private network. All servers must be detached first.
+func (c *ICSClient) DeletePrivateNetwork(ctx context.Context, networkID int) error {
+	endpoint := fmt.Sprintf("/rest-api/private-networks/%d", networkID)
+	resp, err := c.makeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete private network: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// AttachServerToPrivateNetwork attaches a server to a private network
+func (c *ICSClient) AttachServerToPrivateNetwork(ctx context.Context, networkID int, request PrivateNetworkAttachRequest) (*PrivateNetworkAttachment, error) {
+	endpoint := fmt.Sprintf("/rest-api/private-networks/%d/servers", networkID)
+	resp, err := c.makeRequest(ctx, "POST", endpoint, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach server to private network: %w", err)
+	}
+	defer resp.Body.Close()
+	defer c.invalidateServersCache()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to PrivateNetworkAttachment
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var attachment PrivateNetworkAttachment
+	if err := json.Unmarshal(dataBytes, &attachment); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal private network attachment data: %w", err)
+	}
+
+	return &attachment, nil
+}
+
+// DetachServerFromPrivateNetwork detaches a server from a private network
+func (c *ICSClient) DetachServerFromPrivateNetwork(ctx context.Context, networkID int, serverID string) error {
+	endpoint := fmt.Sprintf("/rest-api/private-networks/%d/servers/%s", networkID, serverID)
+	resp, err := c.makeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to detach server from private network: %w", err)
+	}
+	defer resp.Body.Close()
+	defer c.invalidateServersCache()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// unmarshalPrivateNetwork decodes a private network from an API response body
+func unmarshalPrivateNetwork(body []byte) (*PrivateNetwork, error) {
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to PrivateNetwork
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var network PrivateNetwork
+	if err := json.Unmarshal(dataBytes, &network); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal private network data: %w", err)
+	}
+
+	return &network, nil
+}
+
+// CreateFirewall creates a firewall with its rules
+func (c *ICSClient) CreateFirewall(ctx context.Context, request FirewallRequest) (*Firewall, error) {
+	resp, err := c.makeRequest(ctx, "POST", "/rest-api/firewalls", request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create firewall: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return unmarshalFirewall(body)
+}
+
+// GetFirewalls retrieves all firewalls
+func (c *ICSClient) GetFirewalls(ctx context.Context) ([]Firewall, error) {
+	resp, err := c.makeRequest(ctx, "GET", "/rest-api/firewalls", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get firewalls: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to []Firewall
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var firewalls []Firewall
+	if err := json.Unmarshal(dataBytes, &firewalls); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal firewalls data: %w", err)
+	}
+
+	return firewalls, nil
+}
+
+// GetFirewall retrieves a firewall by ID
+func (c *ICSClient) GetFirewall(ctx context.Context, firewallID int) (*Firewall, error) {
+	endpoint := fmt.Sprintf("/rest-api/firewalls/%d", firewallID)
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get firewall: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("firewall %d %w", firewallID, ErrNotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return unmarshalFirewall(body)
+}
+
+// UpdateFirewall renames a firewall and replaces its whole rule set in a single request,
+// so servers are never left without rules while the change is applied
+func (c *ICSClient) UpdateFirewall(ctx context.Context, firewallID int, request FirewallRequest) (*Firewall, error) {
+	endpoint := fmt.Sprintf("/rest-api/firewalls/%d", firewallID)
+	resp, err := c.makeRequest(ctx, "PUT", endpoint, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update firewall: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return unmarshalFirewall(body)
+}
+
+// SetFirewallServers replaces the set of servers a firewall applies to
+func (c *ICSClient) SetFirewallServers(ctx context.Context, firewallID int, serverIDs []string) error {
+	endpoint := fmt.Sprintf("/rest-api/firewalls/%d/servers", firewallID)
+
+	request := FirewallServersRequest{
+		ServerIDs: serverIDs,
+	}
+
+	resp, err := c.makeRequest(ctx, "PUT", endpoint, request)
+	if err != nil {
+		return fmt.Errorf("failed to set firewall servers: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DeleteFirewall deletes a firewall and removes its rules from all servers
+func (c *ICSClient) DeleteFirewall(ctx context.Context, firewallID int) error {
+	endpoint := fmt.Sprintf("/rest-api/firewalls/%d", firewallID)
+	resp, err := c.makeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete firewall: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// unmarshalFirewall decodes a firewall from an API response body
+func unmarshalFirewall(body []byte) (*Firewall, error) {
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to Firewall
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var firewall Firewall
+	if err := json.Unmarshal(dataBytes, &firewall); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal firewall data: %w", err)
+	}
+
+	return &firewall, nil
+}
+
+// RequestConsoleAccess requests temporary IPMI/KVM console credentials for a server
+func (c *ICSClient) RequestConsoleAccess(ctx context.Context, serverID string, request ConsoleAccessRequest) (*ConsoleAccess, error) {
+	endpoint := fmt.Sprintf("/rest-api/servers/%s/console-access", serverID)
+	resp, err := c.makeRequest(ctx, "POST", endpoint, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request console access: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to ConsoleAccess
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var access ConsoleAccess
+	if err := json.Unmarshal(dataBytes, &access); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal console access data: %w", err)
+	}
+
+	return &access, nil
+}
+
+// GetServerBandwidth retrieves a server's bandwidth usage. A zero start or end
+// leaves that bound to the API, which defaults to the current billing period.
+func (c *ICSClient) GetServerBandwidth(ctx context.Context, serverID string, start, end time.Time) (*BandwidthUsage, error) {
+	query := url.Values{}
+	if !start.IsZero() {
+		query.Set("start", start.UTC().Format(time.RFC3339))
+	}
+	if !end.IsZero() {
+		query.Set("end", end.UTC().Format(time.RFC3339))
+	}
+
+	endpoint := fmt.Sprintf("/rest-api/servers/%s/bandwidth", serverID)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server bandwidth: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to BandwidthUsage
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var usage BandwidthUsage
+	if err := json.Unmarshal(dataBytes, &usage); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal bandwidth data: %w", err)
+	}
+
+	return &usage, nil
+}
+
+// GetBillingSummary retrieves the account's charges for the current billing period
+func (c *ICSClient) GetBillingSummary(ctx context.Context) (*BillingSummary, error) {
+	resp, err := c.makeRequest(ctx, "GET", "/rest-api/billing/summary", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get billing summary: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	// Keep numbers as written so amounts are not rounded through float64
+	var apiResp APIResponse
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to BillingSummary
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var summary BillingSummary
+	if err := json.Unmarshal(dataBytes, &summary); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal billing summary data: %w", err)
+	}
+
+	return &summary, nil
+}
+
+// GetLocations retrieves all locations
+func (c *ICSClient) GetLocations(ctx context.Context) ([]Location, error) {
+	resp, err := c.makeRequest(ctx, "GET", "/rest-api/locations", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get locations: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp APIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to []Location
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var locations []Location
+	if err := json.Unmarshal(dataBytes, &locations); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal locations data: %w", err)
+	}
+
+	return locations, nil
+}
+
+// GetAccount retrieves the account the API token belongs to
+func (c *ICSClient) GetAccount(ctx context.Context) (*Account, error) {
+	resp, err := c.makeRequest(ctx, "GET", "/rest-api/account", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	// Keep numbers as written so the balance is not rounded through float64
+	var apiResp APIResponse
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&apiResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Convert the data interface{} to Account
+	dataBytes, err := json.Marshal(apiResp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	var account Account
+	if err := json.Unmarshal(dataBytes, &account); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal account data: %w", err)
+	}
+
+	return &account, nil
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// sensitiveValuePatterns are masked wherever they appear in logged fields,
+// such as request and response bodies
+var sensitiveValuePatterns = []*regexp.Regexp{
+	// root_password, password, new_password and similar JSON fields
+	regexp.MustCompile(`"[A-Za-z_]*password"\s*:\s*"(?:[^"\\]|\\.)*"`),
+	// cloud-init user data may embed secrets
+	regexp.MustCompile(`"user_data(?:_base64)?"\s*:\s*"(?:[^"\\]|\\.)*"`),
+	// console URLs carry a session token that grants access to the server
+	regexp.MustCompile(`"console_url"\s*:\s*"(?:[^"\\]|\\.)*"`),
+	// OpenSSH public keys
+	regexp.MustCompile(`(?:ssh-(?:rsa|dss|ed25519)|ecdsa-sha2-nistp\d+|sk-[a-z0-9-]+@openssh\.com)\s+[A-Za-z0-9+/]+={0,3}`),
+}
+
+// logContext adds masks for the API token and other secrets to the context
+// of the operation making a request
+func (c *ICSClient) logContext(ctx context.Context) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "http_header_x_api_token")
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, sensitiveValuePatterns...)
+	if c.APIToken != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, c.APIToken)
+		ctx = tflog.MaskMessageStrings(ctx, c.APIToken)
+	}
+
+	return ctx
+}
+
+// logRequest logs an outgoing API request
+func (c *ICSClient) logRequest(ctx context.Context, req *http.Request, body []byte) {
+	fields := map[string]interface{}{
+		"http_method":             req.Method,
+		"http_path":               req.URL.Path,
+		"http_request_body_size":  len(body),
+		"http_header_x_api_token": req.Header.Get("X-Api-Token"),
+		"http_header_user_agent":  req.Header.Get("User-Agent"),
+	}
+	if c.LogBodies && len(body) > 0 {
+		fields["http_request_body"] = string(body)
+	}
+
+	tflog.Trace(ctx, "Sending ICS API request", fields)
+}
+
+// logResponse logs an API response, or the error that prevented one
+func (c *ICSClient) logResponse(ctx context.Context, req *http.Request, resp *http.Response, body []byte, duration time.Duration, err error) {
+	fields := map[string]interface{}{
+		"http_method":      req.Method,
+		"http_path":        req.URL.Path,
+		"http_duration_ms": duration.Milliseconds(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "ICS API request failed", fields)
+		return
+	}
+
+	fields["http_status"] = resp.StatusCode
+	fields["http_response_body_size"] = len(body)
+
+	// Include the API's message, which explains most errors
+	var apiResp struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &apiResp) == nil && apiResp.Message != "" {
+		fields["api_message"] = apiResp.Message
+	}
+
+	tflog.Debug(ctx, "Received ICS API response", fields)
+
+	if c.LogBodies && len(body) > 0 {
+		tflog.Trace(ctx, "ICS API response body", map[string]interface{}{
+			"http_method":        req.Method,
+			"http_path":          req.URL.Path,
+			"http_response_body": string(body),
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+const testLoggedPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIATeSylbRFpi9jkO+CRQE+YYc0MT3H+eTd6seEJU4CH+"
+
+func TestMakeRequestLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"statusCode":201,"message":"Key created","data":{"root_password":"hunter2-response","console_url":"https://console.example.com/?token=console-secret"}}`))
+	}))
+	defer server.Close()
+
+	for _, logBodies := range []bool{false, true} {
+		var output bytes.Buffer
+		client := NewICSClient("secret-token", server.URL)
+		client.LogBodies = logBodies
+
+		// Entries must carry the fields of the calling operation's context
+		ctx := tflogtest.RootLogger(context.Background(), &output)
+		ctx = tflog.SetField(ctx, "tf_req_id", "req-123")
+
+		body := map[string]string{"label": "deploy", "public_key": testLoggedPublicKey + " user@host", "root_password": "hunter2-request"}
+		resp, err := client.makeRequest(ctx, "POST", "/rest-api/ssh-keys", body)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+
+		entries, err := tflogtest.MultilineJSONDecode(&output)
+		if err != nil {
+			t.Fatalf("failed to decode logs: %s", err)
+		}
+		if len(entries) < 2 {
+			t.Fatalf("expected request and response log entries, got %d", len(entries))
+		}
+
+		// Check decoded values so JSON bodies are compared unescaped
+		var logs strings.Builder
+		for _, entry := range entries {
+			if entry["tf_req_id"] != "req-123" {
+				t.Errorf("logBodies=%t: expected tf_req_id from the caller's context, got %v", logBodies, entry["tf_req_id"])
+			}
+			for _, value := range entry {
+				fmt.Fprintf(&logs, "%v\n", value)
+			}
+		}
+
+		for _, secret := range []string{"secret-token", "hunter2-request", "hunter2-response", "AAAAC3NzaC1lZDI1NTE5", "console-secret"} {
+			if strings.Contains(logs.String(), secret) {
+				t.Errorf("logBodies=%t: logs contain %q", logBodies, secret)
+			}
+		}
+
+		if !strings.Contains(logs.String(), "Key created") || !strings.Contains(logs.String(), "/rest-api/ssh-keys") {
+			t.Errorf("logBodies=%t: expected the path and API message to be logged", logBodies)
+		}
+
+		if got := strings.Contains(logs.String(), `"label":"deploy"`); got != logBodies {
+			t.Errorf("logBodies=%t: request body logged = %t", logBodies, got)
+		}
+	}
+}
@@ -0,0 +1,436 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestGetServerByServiceIDSharesServerList(t *testing.T) {
+	var mu sync.Mutex
+	listCalls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest-api/servers" {
+			http.NotFound(w, r)
+			return
+		}
+		mu.Lock()
+		listCalls++
+		mu.Unlock()
+		w.Write([]byte(`{"statusCode":200,"message":"OK","data":[{"id":"abc","service_id":1},{"id":"def","service_id":2}]}`))
+	}))
+	defer server.Close()
+
+	client := NewICSClient("token", server.URL)
+
+	var wg sync.WaitGroup
+	for _, serviceID := range []int{1, 2, 1, 2} {
+		wg.Add(1)
+		go func(serviceID int) {
+			defer wg.Done()
+			if _, err := client.GetServerByServiceID(context.Background(), serviceID); err != nil {
+				t.Errorf("unexpected error for service ID %d: %s", serviceID, err)
+			}
+		}(serviceID)
+	}
+	wg.Wait()
+
+	if listCalls != 1 {
+		t.Fatalf("expected 1 server list call, got %d", listCalls)
+	}
+
+	client.invalidateServersCache()
+	if _, err := client.GetServerByServiceID(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if listCalls != 2 {
+		t.Fatalf("expected invalidation to trigger a new list call, got %d calls", listCalls)
+	}
+
+	if _, err := client.GetServerByServiceID(context.Background(), 3); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for missing service ID, got %v", err)
+	}
+}
+
+func TestGetServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest-api/servers/abc" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"id":"abc","service_id":1,"hostname":"db-01"}}`))
+	}))
+	defer server.Close()
+
+	client := NewICSClient("token", server.URL)
+
+	got, err := client.GetServer(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.Hostname != "db-01" {
+		t.Fatalf("expected hostname db-01, got %s", got.Hostname)
+	}
+
+	if _, err := client.GetServer(context.Background(), "missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for missing server, got %v", err)
+	}
+}
+
+func TestGetInventoryPrices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"statusCode":200,"message":"OK","data":[` +
+			`{"sku_id":1,"price":"$1,299.00","price_hourly":1.78},` +
+			`{"sku_id":2,"price":99.10,"price_hourly":""},` +
+			`{"sku_id":3,"price":null,"price_hourly":"n/a"},` +
+			`{"sku_id":4,"price":"Inf","price_hourly":"1e5"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewICSClient("token", server.URL)
+
+	inventory, err := client.GetInventory(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(inventory) != 4 {
+		t.Fatalf("expected 4 items, got %d", len(inventory))
+	}
+
+	tests := []struct {
+		price      Price
+		wantText   string
+		wantAmount string
+	}{
+		{inventory[0].Price, "$1,299.00", "1299"},
+		{inventory[0].PriceHourly, "1.78", "1.78"},
+		{inventory[1].Price, "99.10", "99.1"},
+		{inventory[1].PriceHourly, "", ""},
+		{inventory[2].Price, "", ""},
+		{inventory[2].PriceHourly, "n/a", ""},
+		{inventory[3].Price, "Inf", ""},
+		{inventory[3].PriceHourly, "1e5", ""},
+	}
+
+	for i, tt := range tests {
+		if tt.price.Text != tt.wantText {
+			t.Errorf("price %d: expected text %q, got %q", i, tt.wantText, tt.price.Text)
+		}
+
+		gotAmount := ""
+		if tt.price.Amount != nil {
+			gotAmount = tt.price.Amount.Text('f', -1)
+		}
+		if gotAmount != tt.wantAmount {
+			t.Errorf("price %d: expected amount %q, got %q", i, tt.wantAmount, gotAmount)
+		}
+	}
+}
+
+func TestGetAccountUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Token") != "valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"statusCode":401,"message":"Unauthorized"}`))
+			return
+		}
+		w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"id":42,"name":"Example Ltd","balance":-1234.10,"permissions":["servers:read"]}}`))
+	}))
+	defer server.Close()
+
+	account, err := NewICSClient("valid", server.URL).GetAccount(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if account.ID != 42 || len(account.Permissions) != 1 {
+		t.Fatalf("unexpected account: %+v", account)
+	}
+	if account.Balance.Amount == nil || account.Balance.Amount.Text('f', -1) != "-1234.1" {
+		t.Errorf("expected an exact balance of -1234.1, got %v", account.Balance.Amount)
+	}
+
+	_, err = NewICSClient("revoked", server.URL).GetAccount(context.Background())
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got: %v", err)
+	}
+}
+
+func TestMakeRequestUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("X-Api-Token") {
+		case "revoked":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"statusCode":401,"message":"Unauthorized"}`))
+		case "read-only":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"statusCode":403,"message":"Forbidden"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"statusCode":500,"message":"Server error"}`))
+		}
+	}))
+	defer server.Close()
+
+	// Every client method sees a rejected token as ErrUnauthorized
+	for _, token := range []string{"revoked", "read-only"} {
+		client := NewICSClient(token, server.URL)
+		if _, err := client.GetServers(context.Background()); !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("%s: expected ErrUnauthorized from GetServers, got: %v", token, err)
+		}
+		if err := client.DeleteSSHKey(context.Background(), 1); !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("%s: expected ErrUnauthorized from DeleteSSHKey, got: %v", token, err)
+		}
+	}
+
+	if _, err := NewICSClient("other", server.URL).GetServers(context.Background()); err == nil || errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected a server error, got: %v", err)
+	}
+}
+
+func TestGetReverseDNSNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest-api/reverse-dns/192.0.2.10":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"IP address not found"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"statusCode":500,"message":"Server error"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewICSClient("token", server.URL)
+
+	if _, err := client.GetReverseDNS(context.Background(), "192.0.2.10"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := client.GetReverseDNS(context.Background(), "192.0.2.11"); err == nil || errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected a non-ErrNotFound error, got %v", err)
+	}
+}
+
+func TestIPAllocationRequests(t *testing.T) {
+	var orderBody string
+	cancelled := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/rest-api/ip-allocations":
+			body, _ := io.ReadAll(r.Body)
+			orderBody = string(body)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"statusCode":201,"message":"Created","data":{"id":42,"location_code":"NYC1","type":"ipv4","prefix_length":29,"status":"pending"}}`))
+		case r.Method == "GET" && r.URL.Path == "/rest-api/ip-allocations/42":
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"id":42,"cidr":"203.0.113.8/29","addresses":["203.0.113.9","203.0.113.10"],"gateway":"203.0.113.14","status":"active"}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/rest-api/ip-allocations/42":
+			cancelled = true
+			w.Write([]byte(`{"statusCode":200,"message":"Cancelled"}`))
+		case r.URL.Path == "/rest-api/ip-allocations/43":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"Allocation not found"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"statusCode":500,"message":"Server error"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewICSClient("token", server.URL)
+	ctx := context.Background()
+
+	allocation, err := client.OrderIPAllocation(ctx, IPAllocationOrderRequest{LocationCode: "NYC1", Type: "ipv4", PrefixLength: 29})
+	if err != nil {
+		t.Fatalf("unexpected order error: %s", err)
+	}
+	if allocation.ID != 42 || allocation.Status != "pending" {
+		t.Errorf("unexpected allocation: %+v", allocation)
+	}
+	// server_id is omitted for allocations ordered by location
+	if orderBody != `{"location_code":"NYC1","type":"ipv4","prefix_length":29}` {
+		t.Errorf("unexpected order body: %s", orderBody)
+	}
+
+	allocation, err = client.GetIPAllocation(ctx, 42)
+	if err != nil {
+		t.Fatalf("unexpected get error: %s", err)
+	}
+	if allocation.CIDR != "203.0.113.8/29" || len(allocation.Addresses) != 2 || allocation.Gateway != "203.0.113.14" {
+		t.Errorf("unexpected allocation: %+v", allocation)
+	}
+
+	if _, err := client.GetIPAllocation(ctx, 43); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := client.GetIPAllocation(ctx, 44); err == nil || errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected a non-ErrNotFound error, got %v", err)
+	}
+
+	if err := client.CancelIPAllocation(ctx, 42); err != nil {
+		t.Fatalf("unexpected cancel error: %s", err)
+	}
+	if !cancelled {
+		t.Error("expected a DELETE request")
+	}
+	if err := client.CancelIPAllocation(ctx, 44); err == nil {
+		t.Fatal("expected a cancel error")
+	}
+}
+
+func TestPrivateNetworkRequests(t *testing.T) {
+	requests := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests[r.Method+" "+r.URL.Path] = string(body)
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /rest-api/private-networks":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"statusCode":201,"message":"Created","data":{"id":7,"name":"backend","datacenter_id":1,"vlan_id":120,"cidr":"10.0.0.0/24","servers":[]}}`))
+		case "GET /rest-api/private-networks/7":
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"id":7,"name":"backend","datacenter_id":1,"datacenter_name":"NYC1","vlan_id":120,"cidr":"10.0.0.0/24","servers":[{"server_id":"abc123","private_ip":"10.0.0.2"}]}}`))
+		case "GET /rest-api/private-networks/8":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"Private network not found"}`))
+		case "PUT /rest-api/private-networks/7", "DELETE /rest-api/private-networks/7", "DELETE /rest-api/private-networks/7/servers/abc123":
+			w.Write([]byte(`{"statusCode":200,"message":"OK"}`))
+		case "POST /rest-api/private-networks/7/servers":
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"server_id":"abc123","private_ip":"10.0.0.2"}}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"statusCode":500,"message":"Server error"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewICSClient("token", server.URL)
+	ctx := context.Background()
+
+	network, err := client.CreatePrivateNetwork(ctx, PrivateNetworkRequest{Name: "backend", DatacenterID: 1, CIDR: "10.0.0.0/24"})
+	if err != nil {
+		t.Fatalf("unexpected create error: %s", err)
+	}
+	if network.ID != 7 || network.VlanID != 120 {
+		t.Errorf("unexpected network: %+v", network)
+	}
+	if got := requests["POST /rest-api/private-networks"]; got != `{"name":"backend","datacenter_id":1,"cidr":"10.0.0.0/24"}` {
+		t.Errorf("unexpected create body: %s", got)
+	}
+
+	network, err = client.GetPrivateNetwork(ctx, 7)
+	if err != nil {
+		t.Fatalf("unexpected get error: %s", err)
+	}
+	if network.DatacenterName != "NYC1" || len(network.Attachments) != 1 || network.Attachments[0].PrivateIP != "10.0.0.2" {
+		t.Errorf("unexpected network: %+v", network)
+	}
+	if _, err := client.GetPrivateNetwork(ctx, 8); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := client.GetPrivateNetwork(ctx, 9); err == nil || errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected a non-ErrNotFound error, got %v", err)
+	}
+
+	// A rename only sends the name
+	if err := client.RenamePrivateNetwork(ctx, 7, "frontend"); err != nil {
+		t.Fatalf("unexpected rename error: %s", err)
+	}
+	if got := requests["PUT /rest-api/private-networks/7"]; got != `{"name":"frontend"}` {
+		t.Errorf("unexpected rename body: %s", got)
+	}
+
+	attachment, err := client.AttachServerToPrivateNetwork(ctx, 7, PrivateNetworkAttachRequest{ServerID: "abc123"})
+	if err != nil {
+		t.Fatalf("unexpected attach error: %s", err)
+	}
+	if attachment.PrivateIP != "10.0.0.2" {
+		t.Errorf("unexpected attachment: %+v", attachment)
+	}
+	if got := requests["POST /rest-api/private-networks/7/servers"]; got != `{"server_id":"abc123"}` {
+		t.Errorf("unexpected attach body: %s", got)
+	}
+
+	if err := client.DetachServerFromPrivateNetwork(ctx, 7, "abc123"); err != nil {
+		t.Fatalf("unexpected detach error: %s", err)
+	}
+	if err := client.DeletePrivateNetwork(ctx, 7); err != nil {
+		t.Fatalf("unexpected delete error: %s", err)
+	}
+	if err := client.DeletePrivateNetwork(ctx, 9); err == nil {
+		t.Fatal("expected a delete error")
+	}
+}
+
+func TestFirewallRequests(t *testing.T) {
+	requests := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests[r.Method+" "+r.URL.Path] = string(body)
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /rest-api/firewalls":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"statusCode":201,"message":"Created","data":{"id":5,"name":"web","rules":[{"direction":"ingress","protocol":"tcp","port_range":"443","action":"accept"}],"server_ids":[]}}`))
+		case "GET /rest-api/firewalls/5":
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"id":5,"name":"web","rules":[{"direction":"ingress","protocol":"tcp","port_range":"443","action":"accept"}],"server_ids":["abc123"]}}`))
+		case "GET /rest-api/firewalls/6":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"Firewall not found"}`))
+		case "PUT /rest-api/firewalls/5/servers", "DELETE /rest-api/firewalls/5":
+			w.Write([]byte(`{"statusCode":200,"message":"OK"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"statusCode":500,"message":"Server error"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewICSClient("token", server.URL)
+	ctx := context.Background()
+
+	rules := []FirewallRule{{Direction: "ingress", Protocol: "tcp", PortRange: "443", Action: "accept"}}
+	firewall, err := client.CreateFirewall(ctx, FirewallRequest{Name: "web", Rules: rules})
+	if err != nil {
+		t.Fatalf("unexpected create error: %s", err)
+	}
+	if firewall.ID != 5 || len(firewall.Rules) != 1 || firewall.Rules[0] != rules[0] {
+		t.Errorf("unexpected firewall: %+v", firewall)
+	}
+	// Empty optional rule fields are omitted
+	if got := requests["POST /rest-api/firewalls"]; got != `{"name":"web","rules":[{"direction":"ingress","protocol":"tcp","port_range":"443","action":"accept"}]}` {
+		t.Errorf("unexpected create body: %s", got)
+	}
+
+	firewall, err = client.GetFirewall(ctx, 5)
+	if err != nil {
+		t.Fatalf("unexpected get error: %s", err)
+	}
+	if len(firewall.ServerIDs) != 1 || firewall.ServerIDs[0] != "abc123" {
+		t.Errorf("unexpected server IDs: %v", firewall.ServerIDs)
+	}
+	if _, err := client.GetFirewall(ctx, 6); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := client.GetFirewall(ctx, 7); err == nil || errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected a non-ErrNotFound error, got %v", err)
+	}
+
+	// Detaching every server sends an empty list rather than null
+	if err := client.SetFirewallServers(ctx, 5, []string{}); err != nil {
+		t.Fatalf("unexpected set servers error: %s", err)
+	}
+	if got := requests["PUT /rest-api/firewalls/5/servers"]; got != `{"server_ids":[]}` {
+		t.Errorf("unexpected set servers body: %s", got)
+	}
+
+	if err := client.DeleteFirewall(ctx, 5); err != nil {
+		t.Fatalf("unexpected delete error: %s", err)
+	}
+	if err := client.DeleteFirewall(ctx, 7); err == nil {
+		t.Fatal("expected a delete error")
+	}
+}
@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultBaseURL is used when no base URL is configured anywhere
+const defaultBaseURL = "https://api.ingenuitycloudservices.com"
+
+// defaultCredentialsFile is read when neither credentials_file nor
+// ICS_CREDENTIALS_FILE is set
+const defaultCredentialsFile = "~/.ics/credentials"
+
+// defaultProfile is used when no profile is selected and the credentials file exists
+const defaultProfile = "default"
+
+// credentialPrecedence is included in Configure diagnostics so users can tell
+// which setting is used when several are present
+const credentialPrecedence = `The API token is taken from the first of:
+  1. the api_token provider argument
+  2. the file named by the api_token_file provider argument
+  3. the profile named by the profile provider argument
+  4. the ICS_API_TOKEN environment variable
+  5. the file named by the ICS_API_TOKEN_FILE environment variable
+  6. the profile named by the ICS_PROFILE environment variable
+  7. the "default" profile, if the credentials file exists
+
+The base URL is taken from the same place as the API token. If that place does not set one, the first base_url provider argument, profile base_url or ICS_BASE_URL environment variable in the order above is used.
+
+Profiles are read from the credentials_file provider argument, the ICS_CREDENTIALS_FILE environment variable or ~/.ics/credentials, and only until an API token is found.`
+
+// credentialSettings holds the provider arguments that affect credentials
+type credentialSettings struct {
+	APIToken        string
+	APITokenFile    string
+	BaseURL         string
+	Profile         string
+	CredentialsFile string
+}
+
+// credentialsProfile is a named section of the credentials file
+type credentialsProfile struct {
+	APIToken     string
+	APITokenFile string
+	BaseURL      string
+}
+
+// providerCredentials are the resolved credentials used to build the client
+type providerCredentials struct {
+	APIToken string
+	BaseURL  string
+	// TokenSource describes where the API token came from, for logging
+	TokenSource string
+}
+
+// credentialSource is one level of the precedence order
+type credentialSource struct {
+	name         string
+	apiToken     string
+	apiTokenFile string
+	baseURL      string
+}
+
+// credentialLoader loads one level of the precedence order. Loaders that read
+// the credentials file are skipped once a token has been found, so a broken
+// file cannot affect an explicitly configured token.
+type credentialLoader struct {
+	fromFile bool
+	load     func() (credentialSource, error)
+}
+
+// resolveCredentials applies the precedence order described by
+// credentialPrecedence. An empty APIToken means no token was found.
+func resolveCredentials(settings credentialSettings, getenv func(string) string) (providerCredentials, error) {
+	credentialsFile := settings.CredentialsFile
+	if credentialsFile == "" {
+		credentialsFile = getenv("ICS_CREDENTIALS_FILE")
+	}
+	if credentialsFile == "" {
+		credentialsFile = defaultCredentialsFile
+	}
+
+	loaders := []credentialLoader{{
+		load: func() (credentialSource, error) {
+			return credentialSource{
+				name:         "provider configuration",
+				apiToken:     settings.APIToken,
+				apiTokenFile: settings.APITokenFile,
+				baseURL:      settings.BaseURL,
+			}, nil
+		},
+	}}
+
+	// A profile named in the configuration outranks the environment, so
+	// aliased providers keep their own account even if ICS_API_TOKEN is set
+	if settings.Profile != "" {
+		loaders = append(loaders, credentialLoader{
+			fromFile: true,
+			load: func() (credentialSource, error) {
+				return profileSource(credentialsFile, settings.Profile, true)
+			},
+		})
+	}
+
+	loaders = append(loaders, credentialLoader{
+		load: func() (credentialSource, error) {
+			return credentialSource{
+				name:         "environment",
+				apiToken:     getenv("ICS_API_TOKEN"),
+				apiTokenFile: getenv("ICS_API_TOKEN_FILE"),
+				baseURL:      getenv("ICS_BASE_URL"),
+			}, nil
+		},
+	})
+
+	if settings.Profile == "" {
+		profile, required := getenv("ICS_PROFILE"), true
+		if profile == "" {
+			profile, required = defaultProfile, false
+		}
+
+		loaders = append(loaders, credentialLoader{
+			fromFile: true,
+			load: func() (credentialSource, error) {
+				return profileSource(credentialsFile, profile, required)
+			},
+		})
+	}
+
+	// The base URL belongs with the token: a token from one place is not sent
+	// to a base URL from another unless its own place sets none
+	creds := providerCredentials{}
+	fallbackBaseURL := ""
+	for _, loader := range loaders {
+		if loader.fromFile && creds.APIToken != "" {
+			continue
+		}
+
+		source, err := loader.load()
+		if err != nil {
+			return providerCredentials{}, err
+		}
+
+		if creds.APIToken == "" {
+			switch {
+			case source.apiToken != "":
+				creds.APIToken = source.apiToken
+				creds.TokenSource = source.name
+			case source.apiTokenFile != "":
+				token, err := readTokenFile(source.apiTokenFile)
+				if err != nil {
+					return providerCredentials{}, fmt.Errorf("%s: %w", source.name, err)
+				}
+				creds.APIToken = token
+				creds.TokenSource = fmt.Sprintf("%s (%s)", source.name, source.apiTokenFile)
+			}
+
+			if creds.APIToken != "" && source.baseURL != "" {
+				creds.BaseURL = source.baseURL
+			}
+		}
+
+		if fallbackBaseURL == "" {
+			fallbackBaseURL = source.baseURL
+		}
+	}
+
+	if creds.BaseURL == "" {
+		creds.BaseURL = fallbackBaseURL
+	}
+	if creds.BaseURL == "" {
+		creds.BaseURL = defaultBaseURL
+	}
+
+	return creds, nil
+}
+
+// profileSource loads a profile from the credentials file. Missing files and
+// profiles are only an error if the profile was explicitly requested.
+func profileSource(credentialsFile, name string, required bool) (credentialSource, error) {
+	path, err := expandHome(credentialsFile)
+	if err != nil {
+		return credentialSource{}, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if !required && errors.Is(err, fs.ErrNotExist) {
+			return credentialSource{}, nil
+		}
+		return credentialSource{}, fmt.Errorf("failed to read credentials file for profile %q: %w", name, err)
+	}
+	defer file.Close()
+
+	profiles, err := parseCredentialsFile(file)
+	if err != nil {
+		return credentialSource{}, fmt.Errorf("failed to parse credentials file %s: %w", path, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		if !required {
+			return credentialSource{}, nil
+		}
+		return credentialSource{}, fmt.Errorf("profile %q not found in credentials file %s", name, path)
+	}
+
+	return credentialSource{
+		name:         fmt.Sprintf("profile %q", name),
+		apiToken:     profile.APIToken,
+		apiTokenFile: profile.APITokenFile,
+		baseURL:      profile.BaseURL,
+	}, nil
+}
+
+// parseCredentialsFile parses an INI style credentials file:
+//
+//	[production]
+//	api_token = ...
+//
+//	[staging]
+//	api_token_file = /run/secrets/ics_staging_token
+//	base_url       = https://staging.example.com
+func parseCredentialsFile(r io.Reader) (map[string]credentialsProfile, error) {
+	profiles := make(map[string]credentialsProfile)
+	current := ""
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+			current = strings.TrimSpace(text[1 : len(text)-1])
+			if current == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", line)
+			}
+			if _, ok := profiles[current]; !ok {
+				profiles[current] = credentialsProfile{}
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected \"[profile]\" or \"key = value\"", line)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+			value = value[1 : len(value)-1]
+		}
+
+		if current == "" {
+			return nil, fmt.Errorf("line %d: %q must be inside a [profile] section", line, key)
+		}
+
+		profile := profiles[current]
+		switch key {
+		case "api_token":
+			profile.APIToken = value
+		case "api_token_file":
+			profile.APITokenFile = value
+		case "base_url":
+			profile.BaseURL = value
+		default:
+			return nil, fmt.Errorf("line %d: unsupported key %q; supported keys are api_token, api_token_file and base_url", line, key)
+		}
+		profiles[current] = profile
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// readTokenFile reads an API token from a file, such as a mounted secret
+func readTokenFile(tokenFile string) (string, error) {
+	path, err := expandHome(tokenFile)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read API token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("API token file %s is empty", path)
+	}
+
+	return token, nil
+}
+
+// expandHome replaces a leading ~ with the user's home directory
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to expand %s: %w", path, err)
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
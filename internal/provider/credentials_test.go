@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCredentialsFile = `# ICS credentials
+[default]
+api_token = default-token
+
+[staging]
+api_token = "staging-token"
+base_url  = https://staging.example.com
+
+; token mounted as a secret
+[production]
+api_token_file = %s
+`
+
+func writeTestCredentials(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "production-token")
+	if err := os.WriteFile(tokenFile, []byte("production-token\n"), 0600); err != nil {
+		t.Fatalf("failed to write token file: %s", err)
+	}
+
+	credentialsFile := filepath.Join(dir, "credentials")
+	content := strings.Replace(testCredentialsFile, "%s", tokenFile, 1)
+	if err := os.WriteFile(credentialsFile, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write credentials file: %s", err)
+	}
+
+	return credentialsFile
+}
+
+func TestResolveCredentials(t *testing.T) {
+	credentialsFile := writeTestCredentials(t)
+
+	tests := []struct {
+		name        string
+		settings    credentialSettings
+		env         map[string]string
+		wantToken   string
+		wantBaseURL string
+	}{
+		{
+			name:        "argument beats everything",
+			settings:    credentialSettings{APIToken: "arg-token", Profile: "staging"},
+			env:         map[string]string{"ICS_API_TOKEN": "env-token"},
+			wantToken:   "arg-token",
+			wantBaseURL: defaultBaseURL,
+		},
+		{
+			name:        "argument base URL goes with argument token",
+			settings:    credentialSettings{APIToken: "arg-token", BaseURL: "https://arg.example.com"},
+			env:         map[string]string{"ICS_BASE_URL": "https://env.example.com"},
+			wantToken:   "arg-token",
+			wantBaseURL: "https://arg.example.com",
+		},
+		{
+			name:        "profile base URL goes with profile token",
+			settings:    credentialSettings{Profile: "staging", BaseURL: "https://arg.example.com"},
+			wantToken:   "staging-token",
+			wantBaseURL: "https://staging.example.com",
+		},
+		{
+			name:        "environment base URL goes with environment token",
+			settings:    credentialSettings{BaseURL: "https://arg.example.com"},
+			env:         map[string]string{"ICS_API_TOKEN": "env-token", "ICS_BASE_URL": "https://env.example.com"},
+			wantToken:   "env-token",
+			wantBaseURL: "https://env.example.com",
+		},
+		{
+			name:        "argument base URL with environment token",
+			settings:    credentialSettings{BaseURL: "https://arg.example.com"},
+			env:         map[string]string{"ICS_API_TOKEN": "env-token"},
+			wantToken:   "env-token",
+			wantBaseURL: "https://arg.example.com",
+		},
+		{
+			name:        "configured profile beats environment",
+			settings:    credentialSettings{Profile: "staging"},
+			env:         map[string]string{"ICS_API_TOKEN": "env-token", "ICS_BASE_URL": "https://env.example.com"},
+			wantToken:   "staging-token",
+			wantBaseURL: "https://staging.example.com",
+		},
+		{
+			name:        "environment beats ICS_PROFILE",
+			env:         map[string]string{"ICS_API_TOKEN": "env-token", "ICS_PROFILE": "staging"},
+			wantToken:   "env-token",
+			wantBaseURL: defaultBaseURL,
+		},
+		{
+			name:        "ICS_PROFILE with token file",
+			env:         map[string]string{"ICS_PROFILE": "production"},
+			wantToken:   "production-token",
+			wantBaseURL: defaultBaseURL,
+		},
+		{
+			name:        "default profile",
+			env:         map[string]string{"ICS_BASE_URL": "https://env.example.com"},
+			wantToken:   "default-token",
+			wantBaseURL: "https://env.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.settings.CredentialsFile = credentialsFile
+			getenv := func(key string) string { return tt.env[key] }
+
+			got, err := resolveCredentials(tt.settings, getenv)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got.APIToken != tt.wantToken {
+				t.Errorf("expected token %q, got %q (from %s)", tt.wantToken, got.APIToken, got.TokenSource)
+			}
+			if got.BaseURL != tt.wantBaseURL {
+				t.Errorf("expected base URL %q, got %q", tt.wantBaseURL, got.BaseURL)
+			}
+		})
+	}
+}
+
+func TestResolveCredentialsMissingFile(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "credentials")
+	getenv := func(string) string { return "" }
+
+	// Without a profile a missing credentials file just means no token
+	got, err := resolveCredentials(credentialSettings{CredentialsFile: missing}, getenv)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.APIToken != "" || got.BaseURL != defaultBaseURL {
+		t.Errorf("expected no token and the default base URL, got %q and %q", got.APIToken, got.BaseURL)
+	}
+
+	if _, err := resolveCredentials(credentialSettings{CredentialsFile: missing, Profile: "staging"}, getenv); err == nil {
+		t.Error("expected an error for a profile in a missing credentials file")
+	}
+}
+
+func TestResolveCredentialsUnknownProfile(t *testing.T) {
+	credentialsFile := writeTestCredentials(t)
+	getenv := func(key string) string {
+		if key == "ICS_PROFILE" {
+			return "qa"
+		}
+		return ""
+	}
+
+	_, err := resolveCredentials(credentialSettings{CredentialsFile: credentialsFile}, getenv)
+	if err == nil || !strings.Contains(err.Error(), `profile "qa" not found`) {
+		t.Fatalf("expected a profile not found error, got: %v", err)
+	}
+}
+
+func TestResolveCredentialsSkipsFileOnceTokenFound(t *testing.T) {
+	malformed := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(malformed, []byte("api_token = outside-a-section\n"), 0600); err != nil {
+		t.Fatalf("failed to write credentials file: %s", err)
+	}
+
+	tests := map[string]struct {
+		settings credentialSettings
+		env      map[string]string
+	}{
+		"argument token":        {settings: credentialSettings{APIToken: "arg-token"}},
+		"environment token":     {env: map[string]string{"ICS_API_TOKEN": "env-token"}},
+		"token and ICS_PROFILE": {env: map[string]string{"ICS_API_TOKEN": "env-token", "ICS_PROFILE": "staging"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt.settings.CredentialsFile = malformed
+			getenv := func(key string) string { return tt.env[key] }
+
+			got, err := resolveCredentials(tt.settings, getenv)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got.APIToken == "" {
+				t.Error("expected a token")
+			}
+		})
+	}
+
+	// The file is still read, and reported, when no token was found elsewhere
+	if _, err := resolveCredentials(credentialSettings{CredentialsFile: malformed}, func(string) string { return "" }); err == nil {
+		t.Error("expected an error for a malformed credentials file")
+	}
+}
+
+func TestParseCredentialsFileErrors(t *testing.T) {
+	tests := map[string]string{
+		"key outside section": "api_token = abc\n",
+		"unsupported key":     "[default]\nregion = nyc\n",
+		"missing equals":      "[default]\napi_token\n",
+		"empty profile name":  "[ ]\n",
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseCredentialsFile(strings.NewReader(content)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FirewallDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &FirewallDataSource{}
+
+func NewFirewallDataSource() datasource.DataSource {
+	return &FirewallDataSource{}
+}
+
+// FirewallDataSource defines the data source implementation.
+type FirewallDataSource struct {
+	client *ICSClient
+}
+
+// FirewallDataSourceModel describes the data source data model.
+type FirewallDataSourceModel struct {
+	ID        types.Int64         `tfsdk:"id"`
+	Name      types.String        `tfsdk:"name"`
+	Rules     []FirewallRuleModel `tfsdk:"rules"`
+	ServerIDs types.Set           `tfsdk:"server_ids"`
+}
+
+func (d *FirewallDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall"
+}
+
+func (d *FirewallDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Firewall data source looks up an existing firewall by ID or name, e.g. to reuse a shared rule set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Firewall identifier. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Firewall name. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Ordered list of rules",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "Traffic direction: `ingress` or `egress`",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol: `tcp`, `udp`, `icmp` or `any`",
+							Computed:            true,
+						},
+						"port_range": schema.StringAttribute{
+							MarkdownDescription: "Single port or range; null for all ports",
+							Computed:            true,
+						},
+						"cidr": schema.StringAttribute{
+							MarkdownDescription: "Source CIDR for ingress rules, destination CIDR for egress rules; null for any address",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Action for matching traffic: `accept` or `drop`",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the rule",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"server_ids": schema.SetAttribute{
+				MarkdownDescription: "Servers the firewall is applied to",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *FirewallDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FirewallDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data FirewallDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.ID.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if data.ID.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Attribute Combination",
+			"Exactly one of id or name must be set.",
+		)
+	}
+}
+
+func (d *FirewallDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var firewall *Firewall
+	if !data.ID.IsNull() {
+		var err error
+		firewall, err = d.client.GetFirewall(ctx, int(data.ID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall %d, got error: %s", data.ID.ValueInt64(), err))
+			return
+		}
+	} else {
+		firewalls, err := d.client.GetFirewalls(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewalls, got error: %s", err))
+			return
+		}
+
+		name := data.Name.ValueString()
+		for i := range firewalls {
+			if firewalls[i].Name != name {
+				continue
+			}
+			if firewall != nil {
+				resp.Diagnostics.AddError("Multiple Firewalls Found", fmt.Sprintf("More than one firewall is named %q. Look it up by id instead.", name))
+				return
+			}
+			firewall = &firewalls[i]
+		}
+
+		if firewall == nil {
+			resp.Diagnostics.AddError("Firewall Not Found", fmt.Sprintf("No firewall named %q was found.", name))
+			return
+		}
+	}
+
+	serverIDs, diags := types.SetValueFrom(ctx, types.StringType, firewall.ServerIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.Int64Value(int64(firewall.ID))
+	data.Name = types.StringValue(firewall.Name)
+	data.Rules = firewallRulesToModel(firewall.Rules)
+	data.ServerIDs = serverIDs
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
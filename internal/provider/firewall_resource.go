@@ -0,0 +1,495 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallResource{}
+var _ resource.ResourceWithImportState = &FirewallResource{}
+var _ resource.ResourceWithValidateConfig = &FirewallResource{}
+
+func NewFirewallResource() resource.Resource {
+	return &FirewallResource{}
+}
+
+// FirewallResource defines the resource implementation.
+type FirewallResource struct {
+	client *ICSClient
+}
+
+// FirewallResourceModel describes the resource data model.
+type FirewallResourceModel struct {
+	ID        types.Int64         `tfsdk:"id"`
+	Name      types.String        `tfsdk:"name"`
+	Rules     []FirewallRuleModel `tfsdk:"rules"`
+	ServerIDs types.Set           `tfsdk:"server_ids"`
+}
+
+// FirewallRuleModel describes a single firewall rule.
+type FirewallRuleModel struct {
+	Direction   types.String `tfsdk:"direction"`
+	Protocol    types.String `tfsdk:"protocol"`
+	PortRange   types.String `tfsdk:"port_range"`
+	CIDR        types.String `tfsdk:"cidr"`
+	Action      types.String `tfsdk:"action"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *FirewallResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall"
+}
+
+func (r *FirewallResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Network firewall with an ordered list of ingress and egress rules applied to bare metal servers",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Firewall identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the firewall. Can be updated in-place.",
+				Required:            true,
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Ordered list of rules. Rules are evaluated top to bottom and the first match wins. The whole list is replaced atomically on every change.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "Traffic direction: `ingress` or `egress`",
+							Required:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol: `tcp`, `udp`, `icmp` or `any`",
+							Required:            true,
+						},
+						"port_range": schema.StringAttribute{
+							MarkdownDescription: "Single port or range (e.g., '22' or '8000-8100'). Only valid for `tcp` and `udp`; all ports if omitted.",
+							Optional:            true,
+						},
+						"cidr": schema.StringAttribute{
+							MarkdownDescription: "Source CIDR for ingress rules, destination CIDR for egress rules (e.g., '203.0.113.0/24'). Any address if omitted.",
+							Optional:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Action for matching traffic: `accept` or `drop`",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the rule",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"server_ids": schema.SetAttribute{
+				MarkdownDescription: "Servers the firewall is applied to (the `id` of `ics_bare_metal_server` resources). Can be updated in-place.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FirewallResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rulesList types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rules"), &rulesList)...)
+
+	if resp.Diagnostics.HasError() || rulesList.IsNull() || rulesList.IsUnknown() {
+		return
+	}
+
+	var rules []FirewallRuleModel
+	resp.Diagnostics.Append(rulesList.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, rule := range rules {
+		// Rules with unknown values are checked again once they are known
+		if rule.Direction.IsUnknown() || rule.Protocol.IsUnknown() || rule.PortRange.IsUnknown() || rule.CIDR.IsUnknown() || rule.Action.IsUnknown() {
+			continue
+		}
+
+		if err := validateFirewallRule(firewallRuleFromModel(rule)); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules").AtListIndex(i),
+				"Invalid Firewall Rule",
+				fmt.Sprintf("Rule %d: %s", i+1, err),
+			)
+		}
+	}
+}
+
+func (r *FirewallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := FirewallRequest{
+		Name:  data.Name.ValueString(),
+		Rules: firewallRulesFromModel(data.Rules),
+	}
+
+	tflog.Info(ctx, "Creating firewall", map[string]interface{}{
+		"name":  createReq.Name,
+		"rules": len(createReq.Rules),
+	})
+
+	firewall, err := r.client.CreateFirewall(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create firewall, got error: %s", err))
+		return
+	}
+
+	data.ID = types.Int64Value(int64(firewall.ID))
+
+	// Save the ID before applying to servers so a failure does not leak the firewall
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverIDs, diags := firewallServerIDs(ctx, data.ServerIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(serverIDs) > 0 {
+		err = r.client.SetFirewallServers(ctx, firewall.ID, serverIDs)
+		if err != nil {
+			resp.Diagnostics.AddError("Firewall Attachment Failed", fmt.Sprintf("Unable to apply firewall %d to servers: %s", firewall.ID, err))
+			return
+		}
+	}
+}
+
+func (r *FirewallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	firewallID := int(data.ID.ValueInt64())
+	firewall, err := r.client.GetFirewall(ctx, firewallID)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Firewall no longer exists, removing from state", map[string]interface{}{
+			"id": firewallID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall %d, got error: %s", firewallID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateModelFromFirewall(ctx, &data, firewall)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FirewallResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	firewallID := int(state.ID.ValueInt64())
+
+	// Apply the new rules before changing servers, so newly added servers get the new rules
+	// and the full rule set is swapped in one request
+	if !plan.Name.Equal(state.Name) || !firewallRulesEqual(plan.Rules, state.Rules) {
+		tflog.Info(ctx, "Replacing firewall rules", map[string]interface{}{
+			"id":    firewallID,
+			"rules": len(plan.Rules),
+		})
+
+		_, err := r.client.UpdateFirewall(ctx, firewallID, FirewallRequest{
+			Name:  plan.Name.ValueString(),
+			Rules: firewallRulesFromModel(plan.Rules),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall %d, got error: %s", firewallID, err))
+			return
+		}
+	}
+
+	if !plan.ServerIDs.Equal(state.ServerIDs) {
+		serverIDs, diags := firewallServerIDs(ctx, plan.ServerIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Info(ctx, "Updating firewall servers", map[string]interface{}{
+			"id":         firewallID,
+			"server_ids": serverIDs,
+		})
+
+		err := r.client.SetFirewallServers(ctx, firewallID, serverIDs)
+		if err != nil {
+			resp.Diagnostics.AddError("Firewall Attachment Failed", fmt.Sprintf("Unable to apply firewall %d to servers: %s", firewallID, err))
+			return
+		}
+	}
+
+	plan.ID = state.ID
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FirewallResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	firewallID := int(data.ID.ValueInt64())
+	err := r.client.DeleteFirewall(ctx, firewallID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete firewall %d, got error: %s", firewallID, err))
+		return
+	}
+
+	tflog.Info(ctx, "Firewall deleted successfully", map[string]interface{}{
+		"id": firewallID,
+	})
+}
+
+func (r *FirewallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by firewall ID
+	firewallID, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid firewall ID format: %s", req.ID))
+		return
+	}
+
+	firewall, err := r.client.GetFirewall(ctx, firewallID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read firewall %d: %s", firewallID, err))
+		return
+	}
+
+	data := FirewallResourceModel{
+		ServerIDs: types.SetNull(types.StringType),
+	}
+	resp.Diagnostics.Append(r.updateModelFromFirewall(ctx, &data, firewall)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateModelFromFirewall updates the Terraform model with firewall data
+func (r *FirewallResource) updateModelFromFirewall(ctx context.Context, data *FirewallResourceModel, firewall *Firewall) diag.Diagnostics {
+	data.ID = types.Int64Value(int64(firewall.ID))
+	data.Name = types.StringValue(firewall.Name)
+	data.Rules = firewallRulesToModel(firewall.Rules)
+
+	// Keep server_ids null when it was never configured and no servers are attached
+	if len(firewall.ServerIDs) == 0 && data.ServerIDs.IsNull() {
+		return nil
+	}
+
+	// A nil slice would become a null set and no longer match an empty configured set
+	serverIDs, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, firewall.ServerIDs...))
+	data.ServerIDs = serverIDs
+	return diags
+}
+
+// firewallServerIDs converts the server_ids set into a slice, treating null as empty
+func firewallServerIDs(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	serverIDs := []string{}
+	if set.IsNull() || set.IsUnknown() {
+		return serverIDs, nil
+	}
+
+	diags := set.ElementsAs(ctx, &serverIDs, false)
+	return serverIDs, diags
+}
+
+// firewallRuleFromModel converts a Terraform rule into an API rule
+func firewallRuleFromModel(rule FirewallRuleModel) FirewallRule {
+	return FirewallRule{
+		Direction:   rule.Direction.ValueString(),
+		Protocol:    rule.Protocol.ValueString(),
+		PortRange:   rule.PortRange.ValueString(),
+		CIDR:        rule.CIDR.ValueString(),
+		Action:      rule.Action.ValueString(),
+		Description: rule.Description.ValueString(),
+	}
+}
+
+// firewallRulesFromModel converts Terraform rules into API rules, keeping their order
+func firewallRulesFromModel(rules []FirewallRuleModel) []FirewallRule {
+	result := make([]FirewallRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, firewallRuleFromModel(rule))
+	}
+	return result
+}
+
+// firewallRulesToModel converts API rules into Terraform rules. Empty optional
+// fields become null so they match unset configuration.
+func firewallRulesToModel(rules []FirewallRule) []FirewallRuleModel {
+	optional := func(value string) types.String {
+		if value == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(value)
+	}
+
+	result := make([]FirewallRuleModel, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, FirewallRuleModel{
+			Direction:   types.StringValue(rule.Direction),
+			Protocol:    types.StringValue(rule.Protocol),
+			PortRange:   optional(rule.PortRange),
+			CIDR:        optional(rule.CIDR),
+			Action:      types.StringValue(rule.Action),
+			Description: optional(rule.Description),
+		})
+	}
+	return result
+}
+
+// firewallRulesEqual reports whether two rule lists are identical, including order
+func firewallRulesEqual(a, b []FirewallRuleModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// validateFirewallRule checks a rule's enumerations, port range and CIDR
+func validateFirewallRule(rule FirewallRule) error {
+	switch rule.Direction {
+	case "ingress", "egress":
+	default:
+		return fmt.Errorf("direction must be \"ingress\" or \"egress\", got: %q", rule.Direction)
+	}
+
+	switch rule.Action {
+	case "accept", "drop":
+	default:
+		return fmt.Errorf("action must be \"accept\" or \"drop\", got: %q", rule.Action)
+	}
+
+	switch rule.Protocol {
+	case "tcp", "udp":
+	case "icmp", "any":
+		if rule.PortRange != "" {
+			return fmt.Errorf("port_range can only be set for tcp and udp rules")
+		}
+	default:
+		return fmt.Errorf("protocol must be one of \"tcp\", \"udp\", \"icmp\" or \"any\", got: %q", rule.Protocol)
+	}
+
+	if rule.PortRange != "" {
+		if err := validatePortRange(rule.PortRange); err != nil {
+			return err
+		}
+	}
+
+	if rule.CIDR != "" {
+		if _, _, err := net.ParseCIDR(rule.CIDR); err != nil {
+			return fmt.Errorf("cidr %q is not in CIDR notation", rule.CIDR)
+		}
+	}
+
+	return nil
+}
+
+// validatePortRange checks a single port ("22") or an inclusive range ("8000-8100")
+func validatePortRange(portRange string) error {
+	from, to, isRange := strings.Cut(portRange, "-")
+
+	start, err := strconv.Atoi(from)
+	if err != nil || start < 1 || start > 65535 {
+		return fmt.Errorf("port_range %q must be a port between 1 and 65535 or a range like 8000-8100", portRange)
+	}
+
+	if !isRange {
+		return nil
+	}
+
+	end, err := strconv.Atoi(to)
+	if err != nil || end < 1 || end > 65535 || end < start {
+		return fmt.Errorf("port_range %q must be a port between 1 and 65535 or a range like 8000-8100", portRange)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateFirewallRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    FirewallRule
+		wantErr bool
+	}{
+		{
+			name: "tcp single port",
+			rule: FirewallRule{Direction: "ingress", Protocol: "tcp", PortRange: "22", CIDR: "203.0.113.0/24", Action: "accept"},
+		},
+		{
+			name: "udp range",
+			rule: FirewallRule{Direction: "egress", Protocol: "udp", PortRange: "8000-8100", Action: "drop"},
+		},
+		{
+			name: "any protocol without port",
+			rule: FirewallRule{Direction: "ingress", Protocol: "any", CIDR: "2001:db8::/32", Action: "drop"},
+		},
+		{
+			name:    "unknown direction",
+			rule:    FirewallRule{Direction: "inbound", Protocol: "tcp", Action: "accept"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown action",
+			rule:    FirewallRule{Direction: "ingress", Protocol: "tcp", Action: "allow"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown protocol",
+			rule:    FirewallRule{Direction: "ingress", Protocol: "sctp", Action: "accept"},
+			wantErr: true,
+		},
+		{
+			name:    "port on icmp",
+			rule:    FirewallRule{Direction: "ingress", Protocol: "icmp", PortRange: "8", Action: "accept"},
+			wantErr: true,
+		},
+		{
+			name:    "port out of range",
+			rule:    FirewallRule{Direction: "ingress", Protocol: "tcp", PortRange: "70000", Action: "accept"},
+			wantErr: true,
+		},
+		{
+			name:    "reversed range",
+			rule:    FirewallRule{Direction: "ingress", Protocol: "tcp", PortRange: "443-80", Action: "accept"},
+			wantErr: true,
+		},
+		{
+			name:    "bare address instead of cidr",
+			rule:    FirewallRule{Direction: "ingress", Protocol: "tcp", CIDR: "203.0.113.10", Action: "accept"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFirewallRule(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateFirewallRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFirewallRulesRoundTrip(t *testing.T) {
+	rules := []FirewallRule{
+		{Direction: "ingress", Protocol: "tcp", PortRange: "22", CIDR: "203.0.113.0/24", Action: "accept", Description: "SSH"},
+		{Direction: "ingress", Protocol: "any", Action: "drop"},
+	}
+
+	models := firewallRulesToModel(rules)
+
+	// Unset optional fields are null so they match configuration that omits them
+	if !models[1].PortRange.IsNull() || !models[1].CIDR.IsNull() || !models[1].Description.IsNull() {
+		t.Errorf("expected null optional fields, got %+v", models[1])
+	}
+
+	got := firewallRulesFromModel(models)
+	if len(got) != len(rules) || got[0] != rules[0] || got[1] != rules[1] {
+		t.Errorf("expected %+v, got %+v", rules, got)
+	}
+
+	if !firewallRulesEqual(models, firewallRulesToModel(rules)) {
+		t.Error("expected equal rules")
+	}
+	if firewallRulesEqual(models, firewallRulesToModel([]FirewallRule{rules[1], rules[0]})) {
+		t.Error("expected reordered rules to differ")
+	}
+}
+
+func TestUpdateModelFromFirewallServerIDs(t *testing.T) {
+	r := &FirewallResource{}
+
+	// server_ids stays null when it was never configured and nothing is attached
+	data := FirewallResourceModel{ServerIDs: types.SetNull(types.StringType)}
+	r.updateModelFromFirewall(context.Background(), &data, &Firewall{ID: 5, Name: "web"})
+	if !data.ServerIDs.IsNull() {
+		t.Errorf("expected null server_ids, got %s", data.ServerIDs)
+	}
+
+	// Servers attached outside Terraform show up as drift
+	r.updateModelFromFirewall(context.Background(), &data, &Firewall{ID: 5, Name: "web", ServerIDs: []string{"abc123"}})
+	if len(data.ServerIDs.Elements()) != 1 {
+		t.Errorf("expected one server ID, got %s", data.ServerIDs)
+	}
+
+	// An empty configured set stays empty rather than becoming null
+	data.ServerIDs = types.SetValueMust(types.StringType, nil)
+	r.updateModelFromFirewall(context.Background(), &data, &Firewall{ID: 5, Name: "web"})
+	if data.ServerIDs.IsNull() || len(data.ServerIDs.Elements()) != 0 {
+		t.Errorf("expected an empty server_ids set, got %s", data.ServerIDs)
+	}
+}
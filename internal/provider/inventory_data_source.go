@@ -52,6 +52,8 @@ type InventoryItemModel struct {
 	SkuProductName        types.String                  `tfsdk:"sku_product_name"`
 	Price                 types.String                  `tfsdk:"price"`
 	PriceHourly           types.String                  `tfsdk:"price_hourly"`
+	PriceAmount           types.Number                  `tfsdk:"price_amount"`
+	PriceHourlyAmount     types.Number                  `tfsdk:"price_hourly_amount"`
 	HourlyEnabled         types.Bool                    `tfsdk:"hourly_enabled"`
 }
 
@@ -192,6 +194,14 @@ func (d *InventoryDataSource) Schema(ctx context.Context, req datasource.SchemaR
 							MarkdownDescription: "Hourly price",
 							Computed:            true,
 						},
+						"price_amount": schema.NumberAttribute{
+							MarkdownDescription: "Price as a number, for comparing and sorting items. Null if the API returned no price.",
+							Computed:            true,
+						},
+						"price_hourly_amount": schema.NumberAttribute{
+							MarkdownDescription: "Hourly price as a number. Null if the API returned no hourly price.",
+							Computed:            true,
+						},
 						"hourly_enabled": schema.BoolAttribute{
 							MarkdownDescription: "Whether hourly billing is enabled",
 							Computed:            true,
@@ -234,7 +244,7 @@ func (d *InventoryDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	// Get inventory from API
-	inventory, err := d.client.GetInventory()
+	inventory, err := d.client.GetInventory(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read inventory, got error: %s", err))
 		return
@@ -275,8 +285,10 @@ func (d *InventoryDataSource) Read(ctx context.Context, req datasource.ReadReque
 			Metadata:              metadata,
 			CurrencyCode:          types.StringValue(item.CurrencyCode),
 			SkuProductName:        types.StringValue(item.SkuProductName),
-			Price:                 types.StringValue(item.Price),
-			PriceHourly:           types.StringValue(item.PriceHourly),
+			Price:                 types.StringValue(item.Price.Text),
+			PriceHourly:           types.StringValue(item.PriceHourly.Text),
+			PriceAmount:           types.NumberValue(item.Price.Amount),
+			PriceHourlyAmount:     types.NumberValue(item.PriceHourly.Amount),
 			HourlyEnabled:         types.BoolValue(item.HourlyEnabled),
 		})
 	}
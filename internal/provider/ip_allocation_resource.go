@@ -0,0 +1,404 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// ipAllocationTimeout is how long to wait for an ordered allocation to become active
+	ipAllocationTimeout = 15 * time.Minute
+	// ipAllocationPollInterval is how often the allocation status is checked
+	ipAllocationPollInterval = 10 * time.Second
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IPAllocationResource{}
+var _ resource.ResourceWithImportState = &IPAllocationResource{}
+var _ resource.ResourceWithValidateConfig = &IPAllocationResource{}
+
+func NewIPAllocationResource() resource.Resource {
+	return &IPAllocationResource{}
+}
+
+// IPAllocationResource defines the resource implementation.
+type IPAllocationResource struct {
+	client *ICSClient
+}
+
+// IPAllocationResourceModel describes the resource data model.
+type IPAllocationResourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	ServerID     types.String `tfsdk:"server_id"`
+	Location     types.String `tfsdk:"location"`
+	Type         types.String `tfsdk:"type"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+
+	// Computed/output fields
+	CIDR      types.String `tfsdk:"cidr"`
+	Addresses types.List   `tfsdk:"addresses"`
+	Gateway   types.String `tfsdk:"gateway"`
+	Netmask   types.String `tfsdk:"netmask"`
+	Status    types.String `tfsdk:"status"`
+}
+
+func (r *IPAllocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_allocation"
+}
+
+func (r *IPAllocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Additional IPv4 addresses or an IPv6 subnet routed to a server or reserved in a location",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "IP allocation identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				MarkdownDescription: "Server to route the addresses to (the `id` of an `ics_bare_metal_server`). Exactly one of `server_id` or `location` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "Location code to reserve the addresses in without routing them to a server (e.g., 'NYC1'). Exactly one of `server_id` or `location` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Address family to allocate: `ipv4` or `ipv6`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prefix_length": schema.Int64Attribute{
+				MarkdownDescription: "Size of the allocation as a prefix length, e.g. 32 for a single IPv4 address, 29 for eight IPv4 addresses or 64 for an IPv6 /64. IPv4 allocations must be between /24 and /32, IPv6 allocations between /48 and /128.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				MarkdownDescription: "Allocated block in CIDR notation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"addresses": schema.ListAttribute{
+				MarkdownDescription: "Usable addresses in the allocation",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Gateway address for the allocation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"netmask": schema.StringAttribute{
+				MarkdownDescription: "Netmask for the allocation (IPv4 only)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Allocation status",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *IPAllocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IPAllocationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IPAllocationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked again once they are known
+	if !data.ServerID.IsUnknown() && !data.Location.IsUnknown() && data.ServerID.IsNull() == data.Location.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("server_id"),
+			"Invalid Attribute Combination",
+			"Exactly one of server_id or location must be set.",
+		)
+	}
+
+	if data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+
+	var minPrefix, maxPrefix int64
+	switch data.Type.ValueString() {
+	case "ipv4":
+		minPrefix, maxPrefix = 24, 32
+	case "ipv6":
+		minPrefix, maxPrefix = 48, 128
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid IP Allocation Type",
+			fmt.Sprintf("type must be \"ipv4\" or \"ipv6\", got: %q", data.Type.ValueString()),
+		)
+		return
+	}
+
+	if data.PrefixLength.IsNull() || data.PrefixLength.IsUnknown() {
+		return
+	}
+
+	if prefix := data.PrefixLength.ValueInt64(); prefix < minPrefix || prefix > maxPrefix {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("prefix_length"),
+			"Invalid Prefix Length",
+			fmt.Sprintf("%s allocations must have a prefix length between %d and %d, got: %d", data.Type.ValueString(), minPrefix, maxPrefix, prefix),
+		)
+	}
+}
+
+func (r *IPAllocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IPAllocationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orderReq := IPAllocationOrderRequest{
+		ServerID:     data.ServerID.ValueString(),
+		LocationCode: data.Location.ValueString(),
+		Type:         data.Type.ValueString(),
+		PrefixLength: int(data.PrefixLength.ValueInt64()),
+	}
+
+	tflog.Info(ctx, "Ordering IP allocation", map[string]interface{}{
+		"server_id":     orderReq.ServerID,
+		"location":      orderReq.LocationCode,
+		"type":          orderReq.Type,
+		"prefix_length": orderReq.PrefixLength,
+	})
+
+	allocation, err := r.client.OrderIPAllocation(ctx, orderReq)
+	if err != nil {
+		resp.Diagnostics.AddError("IP Allocation Order Failed", fmt.Sprintf("Unable to order IP allocation: %s", err))
+		return
+	}
+
+	// Save the ID right away so a failed wait does not leak the allocation
+	data.ID = types.Int64Value(int64(allocation.ID))
+	r.updateModelFromAllocation(&data, allocation)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allocation, err = r.waitForAllocation(ctx, allocation.ID, ipAllocationTimeout, ipAllocationPollInterval)
+	if err != nil {
+		resp.Diagnostics.AddError("IP Allocation Failed", fmt.Sprintf("IP allocation %d did not become active: %s", data.ID.ValueInt64(), err))
+		return
+	}
+
+	r.updateModelFromAllocation(&data, allocation)
+
+	tflog.Info(ctx, "IP allocation active", map[string]interface{}{
+		"id":   allocation.ID,
+		"cidr": allocation.CIDR,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IPAllocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IPAllocationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allocationID := int(data.ID.ValueInt64())
+	allocation, err := r.client.GetIPAllocation(ctx, allocationID)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "IP allocation no longer exists, removing from state", map[string]interface{}{
+			"id": allocationID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read IP allocation %d, got error: %s", allocationID, err))
+		return
+	}
+
+	r.updateModelFromAllocation(&data, allocation)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IPAllocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so there is nothing to update in place
+	var data IPAllocationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IPAllocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IPAllocationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allocationID := int(data.ID.ValueInt64())
+	err := r.client.CancelIPAllocation(ctx, allocationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to cancel IP allocation %d, got error: %s", allocationID, err))
+		return
+	}
+
+	tflog.Info(ctx, "IP allocation cancelled successfully", map[string]interface{}{
+		"id": allocationID,
+	})
+}
+
+func (r *IPAllocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by allocation ID
+	allocationID, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid IP allocation ID format: %s", req.ID))
+		return
+	}
+
+	allocation, err := r.client.GetIPAllocation(ctx, allocationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read IP allocation %d: %s", allocationID, err))
+		return
+	}
+
+	data := IPAllocationResourceModel{
+		ID:           types.Int64Value(int64(allocation.ID)),
+		ServerID:     types.StringNull(),
+		Location:     types.StringNull(),
+		Type:         types.StringValue(allocation.Type),
+		PrefixLength: types.Int64Value(int64(allocation.PrefixLength)),
+	}
+	if allocation.ServerID != "" {
+		data.ServerID = types.StringValue(allocation.ServerID)
+	} else {
+		data.Location = types.StringValue(allocation.LocationCode)
+	}
+	r.updateModelFromAllocation(&data, allocation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// waitForAllocation polls the allocation until it is active, failed or the timeout expires
+func (r *IPAllocationResource) waitForAllocation(ctx context.Context, allocationID int, timeout, interval time.Duration) (*IPAllocation, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		allocation, err := r.client.GetIPAllocation(ctx, allocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch allocation.Status {
+		case "active":
+			return allocation, nil
+		case "failed", "cancelled":
+			return nil, fmt.Errorf("allocation status is %q", allocation.Status)
+		}
+
+		tflog.Debug(ctx, "Waiting for IP allocation", map[string]interface{}{
+			"id":     allocationID,
+			"status": allocation.Status,
+		})
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timeout after %s, last status %q", timeout, allocation.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// updateModelFromAllocation updates the Terraform model with allocation data
+func (r *IPAllocationResource) updateModelFromAllocation(data *IPAllocationResourceModel, allocation *IPAllocation) {
+	data.CIDR = types.StringValue(allocation.CIDR)
+	data.Gateway = types.StringValue(allocation.Gateway)
+	data.Netmask = types.StringValue(allocation.Netmask)
+	data.Status = types.StringValue(allocation.Status)
+
+	addresses := []attr.Value{}
+	for _, address := range allocation.Addresses {
+		addresses = append(addresses, types.StringValue(address))
+	}
+	data.Addresses = types.ListValueMust(types.StringType, addresses)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForAllocation(t *testing.T) {
+	tests := map[string]struct {
+		statuses []string
+		wantErr  string
+		wantGets int
+	}{
+		"active immediately":   {statuses: []string{"active"}, wantGets: 1},
+		"pending then active":  {statuses: []string{"pending", "provisioning", "active"}, wantGets: 3},
+		"failed":               {statuses: []string{"pending", "failed"}, wantErr: `allocation status is "failed"`, wantGets: 2},
+		"cancelled":            {statuses: []string{"cancelled"}, wantErr: `allocation status is "cancelled"`, wantGets: 1},
+		"still pending at end": {statuses: []string{"pending"}, wantErr: `last status "pending"`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gets := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" || r.URL.Path != "/rest-api/ip-allocations/42" {
+					http.NotFound(w, r)
+					return
+				}
+				status := tt.statuses[min(gets, len(tt.statuses)-1)]
+				gets++
+				w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"id":42,"cidr":"203.0.113.8/29","status":"` + status + `"}}`))
+			}))
+			defer server.Close()
+
+			r := &IPAllocationResource{client: NewICSClient("token", server.URL)}
+			allocation, err := r.waitForAllocation(context.Background(), 42, 50*time.Millisecond, time.Millisecond)
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if allocation.CIDR != "203.0.113.8/29" {
+					t.Errorf("unexpected allocation: %+v", allocation)
+				}
+			}
+
+			if tt.wantGets != 0 && gets != tt.wantGets {
+				t.Errorf("expected %d status checks, got %d", tt.wantGets, gets)
+			}
+		})
+	}
+}
+
+func TestWaitForAllocationNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"statusCode":404,"message":"Allocation not found"}`))
+	}))
+	defer server.Close()
+
+	r := &IPAllocationResource{client: NewICSClient("token", server.URL)}
+	if _, err := r.waitForAllocation(context.Background(), 42, time.Minute, time.Millisecond); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestWaitForAllocationCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"id":42,"status":"pending"}}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Cancelling the operation stops the wait before the allocation timeout
+	r := &IPAllocationResource{client: NewICSClient("token", server.URL)}
+	if _, err := r.waitForAllocation(ctx, 42, time.Hour, time.Minute); err != context.DeadlineExceeded {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
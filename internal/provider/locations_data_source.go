@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LocationsDataSource{}
+
+func NewLocationsDataSource() datasource.DataSource {
+	return &LocationsDataSource{}
+}
+
+// LocationsDataSource defines the data source implementation.
+type LocationsDataSource struct {
+	client *ICSClient
+}
+
+// LocationsDataSourceModel describes the data source data model.
+type LocationsDataSourceModel struct {
+	Locations []LocationModel `tfsdk:"locations"`
+	ID        types.String    `tfsdk:"id"`
+}
+
+type LocationModel struct {
+	Code                      types.String `tfsdk:"code"`
+	DatacenterID              types.Int64  `tfsdk:"datacenter_id"`
+	DatacenterName            types.String `tfsdk:"datacenter_name"`
+	RegionID                  types.Int64  `tfsdk:"region_id"`
+	Country                   types.String `tfsdk:"country"`
+	City                      types.String `tfsdk:"city"`
+	AutoProvisionableSKUCount types.Int64  `tfsdk:"auto_provisionable_sku_count"`
+	AutoProvisionQuantity     types.Int64  `tfsdk:"auto_provision_quantity"`
+}
+
+func (d *LocationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_locations"
+}
+
+func (d *LocationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Locations data source lists the locations servers can be ordered in, with datacenter details and available inventory.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"locations": schema.ListNestedAttribute{
+				MarkdownDescription: "List of locations",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Location code, as used by the `location` attribute of `ics_bare_metal_server` (e.g., 'NYC1')",
+							Computed:            true,
+						},
+						"datacenter_id": schema.Int64Attribute{
+							MarkdownDescription: "Datacenter identifier",
+							Computed:            true,
+						},
+						"datacenter_name": schema.StringAttribute{
+							MarkdownDescription: "Datacenter name",
+							Computed:            true,
+						},
+						"region_id": schema.Int64Attribute{
+							MarkdownDescription: "Region identifier",
+							Computed:            true,
+						},
+						"country": schema.StringAttribute{
+							MarkdownDescription: "Country the datacenter is in",
+							Computed:            true,
+						},
+						"city": schema.StringAttribute{
+							MarkdownDescription: "City the datacenter is in",
+							Computed:            true,
+						},
+						"auto_provisionable_sku_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of instance types that can currently be auto-provisioned in the location",
+							Computed:            true,
+						},
+						"auto_provision_quantity": schema.Int64Attribute{
+							MarkdownDescription: "Total number of servers that can currently be auto-provisioned in the location, across all instance types",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LocationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LocationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LocationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get locations and inventory from API
+	locations, err := d.client.GetLocations(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read locations, got error: %s", err))
+		return
+	}
+
+	inventory, err := d.client.GetInventory(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read inventory, got error: %s", err))
+		return
+	}
+
+	// Count auto-provisionable inventory per location
+	skuCounts := make(map[string]int)
+	quantities := make(map[string]int)
+	for _, item := range inventory {
+		if item.AutoProvisionQuantity > 0 {
+			skuCounts[item.LocationCode]++
+			quantities[item.LocationCode] += item.AutoProvisionQuantity
+		}
+	}
+
+	// Convert API response to Terraform model
+	results := []LocationModel{}
+	for _, location := range locations {
+		results = append(results, LocationModel{
+			Code:                      types.StringValue(location.Code),
+			DatacenterID:              types.Int64Value(int64(location.DatacenterID)),
+			DatacenterName:            types.StringValue(location.DatacenterName),
+			RegionID:                  types.Int64Value(int64(location.RegionID)),
+			Country:                   types.StringValue(location.Country),
+			City:                      types.StringValue(location.City),
+			AutoProvisionableSKUCount: types.Int64Value(int64(skuCounts[location.Code])),
+			AutoProvisionQuantity:     types.Int64Value(int64(quantities[location.Code])),
+		})
+	}
+
+	data.Locations = results
+	data.ID = types.StringValue("locations")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
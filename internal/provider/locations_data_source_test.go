@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestLocationsRead(t *testing.T) {
+	inventoryFails := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest-api/locations":
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":[
+				{"location_code":"NYC1","datacenter_id":1,"datacenter_name":"New York","region_id":10,"country":"US","city":"New York"},
+				{"location_code":"AMS1","datacenter_id":2,"datacenter_name":"Amsterdam","region_id":20,"country":"NL","city":"Amsterdam"},
+				{"location_code":"LON1","datacenter_id":3,"datacenter_name":"London","region_id":20,"country":"GB","city":"London"}]}`))
+		case r.URL.Path == "/rest-api/server-orders/inventory" && !inventoryFails:
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":[
+				{"sku_id":1,"location_code":"NYC1","quantity":5,"auto_provision_quantity":3},
+				{"sku_id":2,"location_code":"NYC1","quantity":4,"auto_provision_quantity":2},
+				{"sku_id":3,"location_code":"NYC1","quantity":8,"auto_provision_quantity":0},
+				{"sku_id":1,"location_code":"AMS1","quantity":1,"auto_provision_quantity":1},
+				{"sku_id":4,"location_code":"SIN1","quantity":2,"auto_provision_quantity":2}]}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"statusCode":500,"message":"Server error"}`))
+		}
+	}))
+	defer server.Close()
+
+	d := &LocationsDataSource{client: NewICSClient("token", server.URL)}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	read := func() datasource.ReadResponse {
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for key, attrType := range objectType.AttributeTypes {
+			values[key] = tftypes.NewValue(attrType, nil)
+		}
+
+		config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+		resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+		d.Read(context.Background(), datasource.ReadRequest{Config: config}, &resp)
+		return resp
+	}
+
+	resp := read()
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data LocationsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if len(data.Locations) != 3 {
+		t.Fatalf("expected 3 locations, got %d", len(data.Locations))
+	}
+
+	// Only SKUs that can be auto-provisioned count towards a location, and
+	// inventory in unlisted locations is ignored
+	want := map[string][2]int64{"NYC1": {2, 5}, "AMS1": {1, 1}, "LON1": {0, 0}}
+	for _, location := range data.Locations {
+		code := location.Code.ValueString()
+		got := [2]int64{location.AutoProvisionableSKUCount.ValueInt64(), location.AutoProvisionQuantity.ValueInt64()}
+		if got != want[code] {
+			t.Errorf("%s: expected SKU count and quantity %v, got %v", code, want[code], got)
+		}
+	}
+
+	if data.Locations[1].DatacenterID.ValueInt64() != 2 || data.Locations[1].City.ValueString() != "Amsterdam" {
+		t.Errorf("unexpected location: %+v", data.Locations[1])
+	}
+
+	inventoryFails = true
+	if resp := read(); !resp.Diagnostics.HasError() {
+		t.Error("expected an error when inventory cannot be read")
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// hoursPerMonth is the average number of hours in a month (365 * 24 / 12)
+const hoursPerMonth = 730
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &MonthlyCostFunction{}
+
+func NewMonthlyCostFunction() function.Function {
+	return &MonthlyCostFunction{}
+}
+
+// MonthlyCostFunction defines the function implementation.
+type MonthlyCostFunction struct{}
+
+func (f *MonthlyCostFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "monthly_cost"
+}
+
+func (f *MonthlyCostFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert an hourly price into a monthly cost",
+		MarkdownDescription: "Multiplies an hourly price by the average number of hours in a month (730) and rounds the result to two decimal places, half up. The calculation is exact, so an hourly price of 0.1 costs exactly 73.",
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name:                "hourly_price",
+				MarkdownDescription: "Price per hour, e.g. the `price_hourly_amount` of an `ics_inventory` item",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (f *MonthlyCostFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var hourlyPrice *big.Float
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &hourlyPrice))
+	if resp.Error != nil {
+		return
+	}
+
+	if hourlyPrice.Sign() < 0 {
+		resp.Error = function.NewArgumentFuncError(0, "hourly_price must not be negative")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, monthlyCost(hourlyPrice)))
+}
+
+// monthlyCost returns the cost of running for an average month, rounded half
+// up to cents. It works on the decimal the price was written as rather than its
+// binary approximation, so half cents always round up.
+func monthlyCost(hourlyPrice *big.Float) *big.Float {
+	price, _ := new(big.Rat).SetString(hourlyPrice.Text('g', -1))
+	cents := price.Mul(price, big.NewRat(hoursPerMonth*100, 1))
+
+	// Truncating after adding half a cent rounds half up for the non-negative
+	// prices Run accepts
+	cents.Add(cents, big.NewRat(1, 2))
+	whole := new(big.Int).Quo(cents.Num(), cents.Denom())
+
+	return new(big.Float).SetPrec(512).SetRat(new(big.Rat).SetFrac(whole, big.NewInt(100)))
+}
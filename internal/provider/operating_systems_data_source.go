@@ -130,7 +130,7 @@ func (d *OperatingSystemsDataSource) Read(ctx context.Context, req datasource.Re
 	location := data.Location.ValueString()
 
 	// Get addons (which includes operating systems) from API
-	addons, err := d.client.GetAddons(serverTypeName, location)
+	addons, err := d.client.GetAddons(ctx, serverTypeName, location)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read operating systems for server type '%s' in location '%s', got error: %s", serverTypeName, location, err))
 		return
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParsePriceFunction{}
+
+// plainDecimal matches a normalized price. strconv and math/big also accept
+// exponents, hex floats and Inf, none of which are prices.
+var plainDecimal = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+
+func NewParsePriceFunction() function.Function {
+	return &ParsePriceFunction{}
+}
+
+// ParsePriceFunction defines the function implementation.
+type ParsePriceFunction struct{}
+
+func (f *ParsePriceFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_price"
+}
+
+func (f *ParsePriceFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a price string into a number",
+		MarkdownDescription: "Parses a price such as `\"99.00\"`, `\"$1,299.00\"` or `\"12.50 USD\"` into a number. Currency symbols, currency codes and thousands separators are ignored and a leading minus sign is kept, so `\"-$5\"` is -5. What remains must be a plain decimal; exponents, hexadecimal and `\"Inf\"` are rejected. The result is exact, so `\"0.10\"` is 0.1 rather than a nearby floating point value.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "price",
+				MarkdownDescription: "Price to parse, e.g. the `price` or `price_hourly` of an `ics_inventory` item",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (f *ParsePriceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var price string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &price))
+	if resp.Error != nil {
+		return
+	}
+
+	value, err := parsePrice(price)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, value))
+}
+
+// parsePrice parses a price string, ignoring surrounding currency symbols and
+// codes and thousands separators. The amount is parsed at the precision
+// Terraform uses for numbers so decimal prices are not rounded through float64.
+func parsePrice(price string) (*big.Float, error) {
+	trimmed := normalizePrice(price)
+	if !plainDecimal.MatchString(trimmed) {
+		return nil, fmt.Errorf("%q is not a valid price", price)
+	}
+
+	value, _, err := big.ParseFloat(trimmed, 10, 512, big.ToNearestEven)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid price", price)
+	}
+
+	return value, nil
+}
+
+// normalizePrice strips surrounding currency symbols and codes and thousands
+// separators, leaving a plain decimal number. A leading minus sign is kept, so
+// "-$5" becomes "-5".
+func normalizePrice(price string) string {
+	trimmed := strings.TrimSpace(price)
+
+	sign := ""
+	if strings.HasPrefix(trimmed, "-") {
+		sign, trimmed = "-", trimmed[1:]
+	}
+
+	trimmed = strings.TrimFunc(trimmed, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsLetter(r) || unicode.Is(unicode.Sc, r)
+	})
+
+	return sign + strings.ReplaceAll(trimmed, ",", "")
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testDecimal parses a decimal at the precision Terraform uses for numbers
+func testDecimal(t *testing.T, s string) *big.Float {
+	t.Helper()
+
+	value, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+	if err != nil {
+		t.Fatalf("invalid decimal %q: %s", s, err)
+	}
+
+	return value
+}
+
+func TestParsePrice(t *testing.T) {
+	tests := []struct {
+		price   string
+		want    string
+		wantErr bool
+	}{
+		{price: "99.00", want: "99"},
+		{price: "$1,299.50", want: "1299.5"},
+		{price: "12.50 USD", want: "12.5"},
+		{price: " £0.135 ", want: "0.135"},
+		{price: "-$5", want: "-5"},
+		{price: "$-5.25", want: "-5.25"},
+		{price: "0.10", want: "0.1"},
+		{price: "", wantErr: true},
+		{price: "1e5", wantErr: true},
+		{price: "0x1p3", wantErr: true},
+		{price: "Inf", wantErr: true},
+		{price: "-Inf", wantErr: true},
+		{price: "NaN", wantErr: true},
+		{price: "--5", wantErr: true},
+		{price: ".5", wantErr: true},
+		{price: "USD", wantErr: true},
+		{price: "call us", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.price, func(t *testing.T) {
+			got, err := parsePrice(tt.price)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error for %q, got %v", tt.price, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got.Cmp(testDecimal(t, tt.want)) != 0 {
+				t.Errorf("parsePrice(%q) = %s, want %s", tt.price, got.Text('g', -1), tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePriceIsExact(t *testing.T) {
+	got, err := parsePrice("0.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// float64 cannot hold 0.1, so a float64 result would differ from Terraform's 0.1
+	if got.Cmp(big.NewFloat(0.1)) == 0 {
+		t.Errorf("expected 0.1 at 512-bit precision, got the float64 approximation")
+	}
+	if got.Prec() != 512 {
+		t.Errorf("expected 512-bit precision, got %d", got.Prec())
+	}
+}
+
+func TestMonthlyCost(t *testing.T) {
+	tests := map[string]string{
+		"0.1":    "73",
+		"0.135":  "98.55",
+		"0.1234": "90.08",
+		"0.0005": "0.37", // exactly 36.5 cents, rounded up
+		"0.0004": "0.29",
+		"0":      "0",
+		"1.5":    "1095",
+	}
+
+	for hourly, want := range tests {
+		if got := monthlyCost(testDecimal(t, hourly)); got.Cmp(testDecimal(t, want)) != 0 {
+			t.Errorf("monthlyCost(%s) = %s, want %s", hourly, got.Text('g', -1), want)
+		}
+	}
+}
+
+func TestMonthlyCostFunction(t *testing.T) {
+	f := &MonthlyCostFunction{}
+
+	run := func(hourlyPrice string) function.RunResponse {
+		resp := function.RunResponse{Result: function.NewResultData(types.NumberUnknown())}
+		req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.NumberValue(testDecimal(t, hourlyPrice))})}
+		f.Run(context.Background(), req, &resp)
+		return resp
+	}
+
+	resp := run("0.1")
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	if got := resp.Result.Value(); !got.Equal(types.NumberValue(testDecimal(t, "73"))) {
+		t.Errorf("monthly_cost(0.1) = %s, want exactly 73", got)
+	}
+
+	if resp := run("-0.1"); resp.Error == nil {
+		t.Error("expected an error for a negative hourly price")
+	}
+}
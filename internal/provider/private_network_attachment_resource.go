@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PrivateNetworkAttachmentResource{}
+var _ resource.ResourceWithImportState = &PrivateNetworkAttachmentResource{}
+var _ resource.ResourceWithValidateConfig = &PrivateNetworkAttachmentResource{}
+
+func NewPrivateNetworkAttachmentResource() resource.Resource {
+	return &PrivateNetworkAttachmentResource{}
+}
+
+// PrivateNetworkAttachmentResource defines the resource implementation.
+type PrivateNetworkAttachmentResource struct {
+	client *ICSClient
+}
+
+// PrivateNetworkAttachmentResourceModel describes the resource data model.
+type PrivateNetworkAttachmentResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	NetworkID types.Int64  `tfsdk:"network_id"`
+	ServerID  types.String `tfsdk:"server_id"`
+	PrivateIP types.String `tfsdk:"private_ip"`
+}
+
+func (r *PrivateNetworkAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_network_attachment"
+}
+
+func (r *PrivateNetworkAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Attaches a bare metal server to a private network",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Attachment identifier in the form `<network_id>/<server_id>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.Int64Attribute{
+				MarkdownDescription: "Private network identifier (the `id` of an `ics_private_network`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				MarkdownDescription: "Server identifier (the `id` of an `ics_bare_metal_server`). The server must be in the same datacenter as the network.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_ip": schema.StringAttribute{
+				MarkdownDescription: "Address of the server on the private network. Must be within the network's `cidr`. Assigned by ICS if omitted.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PrivateNetworkAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PrivateNetworkAttachmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var privateIP types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_ip"), &privateIP)...)
+
+	if privateIP.IsNull() || privateIP.IsUnknown() {
+		return
+	}
+
+	ip := net.ParseIP(privateIP.ValueString())
+	if ip == nil || !ip.IsPrivate() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_ip"),
+			"Invalid Private IP Address",
+			fmt.Sprintf("%q is not a private IPv4 or IPv6 address.", privateIP.ValueString()),
+		)
+	}
+}
+
+func (r *PrivateNetworkAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PrivateNetworkAttachmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := int(data.NetworkID.ValueInt64())
+	serverID := data.ServerID.ValueString()
+
+	network, err := r.client.GetPrivateNetwork(ctx, networkID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private network %d, got error: %s", networkID, err))
+		return
+	}
+
+	server, err := r.client.GetServer(ctx, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server %s, got error: %s", serverID, err))
+		return
+	}
+
+	// VLANs do not span datacenters
+	if server.DatacenterID != network.DatacenterID {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("server_id"),
+			"Datacenter Mismatch",
+			fmt.Sprintf("Server %s is in datacenter %s (%d) but private network %d is in datacenter %s (%d). Servers can only be attached to private networks in their own datacenter.",
+				serverID, server.DatacenterName, server.DatacenterID, networkID, network.DatacenterName, network.DatacenterID),
+		)
+		return
+	}
+
+	attachReq := PrivateNetworkAttachRequest{
+		ServerID: serverID,
+	}
+	if !data.PrivateIP.IsUnknown() && !data.PrivateIP.IsNull() {
+		attachReq.PrivateIP = data.PrivateIP.ValueString()
+
+		if _, subnet, err := net.ParseCIDR(network.CIDR); err == nil && !subnet.Contains(net.ParseIP(attachReq.PrivateIP)) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_ip"),
+				"Invalid Private IP Address",
+				fmt.Sprintf("%s is not within private network %d's range %s.", attachReq.PrivateIP, networkID, network.CIDR),
+			)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Attaching server to private network", map[string]interface{}{
+		"network_id": networkID,
+		"server_id":  serverID,
+		"private_ip": attachReq.PrivateIP,
+	})
+
+	attachment, err := r.client.AttachServerToPrivateNetwork(ctx, networkID, attachReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Private Network Attachment Failed", fmt.Sprintf("Unable to attach server %s to private network %d: %s", serverID, networkID, err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d/%s", networkID, serverID))
+	data.PrivateIP = types.StringValue(attachment.PrivateIP)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivateNetworkAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PrivateNetworkAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := int(data.NetworkID.ValueInt64())
+	serverID := data.ServerID.ValueString()
+
+	attachment, err := r.findAttachment(ctx, networkID, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private network %d, got error: %s", networkID, err))
+		return
+	}
+
+	// The network or attachment was removed outside Terraform
+	if attachment == nil {
+		tflog.Warn(ctx, "Server is no longer attached to private network, removing from state", map[string]interface{}{
+			"network_id": networkID,
+			"server_id":  serverID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.PrivateIP = types.StringValue(attachment.PrivateIP)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivateNetworkAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so there is nothing to update in place
+	var data PrivateNetworkAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivateNetworkAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PrivateNetworkAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := int(data.NetworkID.ValueInt64())
+	serverID := data.ServerID.ValueString()
+
+	err := r.client.DetachServerFromPrivateNetwork(ctx, networkID, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach server %s from private network %d, got error: %s", serverID, networkID, err))
+		return
+	}
+
+	tflog.Info(ctx, "Server detached from private network successfully", map[string]interface{}{
+		"network_id": networkID,
+		"server_id":  serverID,
+	})
+}
+
+func (r *PrivateNetworkAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by "<network_id>/<server_id>"
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected import identifier in the form <network_id>/<server_id>, got: %s", req.ID))
+		return
+	}
+
+	networkID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid private network ID format: %s", parts[0]))
+		return
+	}
+
+	attachment, err := r.findAttachment(ctx, networkID, parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read private network %d: %s", networkID, err))
+		return
+	}
+
+	if attachment == nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Server %s is not attached to private network %d", parts[1], networkID))
+		return
+	}
+
+	data := PrivateNetworkAttachmentResourceModel{
+		ID:        types.StringValue(fmt.Sprintf("%d/%s", networkID, parts[1])),
+		NetworkID: types.Int64Value(int64(networkID)),
+		ServerID:  types.StringValue(parts[1]),
+		PrivateIP: types.StringValue(attachment.PrivateIP),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findAttachment returns the server's attachment to the network, or nil if either no longer exists
+func (r *PrivateNetworkAttachmentResource) findAttachment(ctx context.Context, networkID int, serverID string) (*PrivateNetworkAttachment, error) {
+	network, err := r.client.GetPrivateNetwork(ctx, networkID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range network.Attachments {
+		if attachment.ServerID == serverID {
+			return &attachment, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testAttachmentValue builds a private network attachment object
+func testAttachmentValue(t *testing.T, id, privateIP tftypes.Value) (tfsdk.Config, tftypes.Value) {
+	t.Helper()
+
+	var resp resource.SchemaResponse
+	NewPrivateNetworkAttachmentResource().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	objectType := resp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	value := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":         id,
+		"network_id": tftypes.NewValue(tftypes.Number, 7),
+		"server_id":  tftypes.NewValue(tftypes.String, "abc123"),
+		"private_ip": privateIP,
+	})
+
+	return tfsdk.Config{Schema: resp.Schema, Raw: value}, value
+}
+
+func TestPrivateNetworkAttachmentValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		privateIP tftypes.Value
+		wantErr   bool
+	}{
+		"null":              {privateIP: tftypes.NewValue(tftypes.String, nil)},
+		"unknown":           {privateIP: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+		"10/8":              {privateIP: tftypes.NewValue(tftypes.String, "10.0.0.5")},
+		"172.16/12":         {privateIP: tftypes.NewValue(tftypes.String, "172.16.4.1")},
+		"192.168/16":        {privateIP: tftypes.NewValue(tftypes.String, "192.168.1.1")},
+		"unique local IPv6": {privateIP: tftypes.NewValue(tftypes.String, "fd00::1")},
+		"public IPv4":       {privateIP: tftypes.NewValue(tftypes.String, "203.0.113.5"), wantErr: true},
+		"public IPv6":       {privateIP: tftypes.NewValue(tftypes.String, "2001:db8::1"), wantErr: true},
+		"CIDR":              {privateIP: tftypes.NewValue(tftypes.String, "10.0.0.5/24"), wantErr: true},
+		"not an address":    {privateIP: tftypes.NewValue(tftypes.String, "db-01"), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			config, _ := testAttachmentValue(t, tftypes.NewValue(tftypes.String, nil), tt.privateIP)
+
+			var resp resource.ValidateConfigResponse
+			(&PrivateNetworkAttachmentResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, &resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("expected error %t, got diagnostics: %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestPrivateNetworkAttachmentCreate(t *testing.T) {
+	tests := map[string]struct {
+		serverDatacenter int
+		privateIP        tftypes.Value
+		wantErr          string
+		wantBody         string
+		wantIP           string
+	}{
+		"assigned address": {
+			serverDatacenter: 1,
+			privateIP:        tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			wantBody:         `{"server_id":"abc123"}`,
+			wantIP:           "10.0.0.2",
+		},
+		"requested address": {
+			serverDatacenter: 1,
+			privateIP:        tftypes.NewValue(tftypes.String, "10.0.0.20"),
+			wantBody:         `{"server_id":"abc123","private_ip":"10.0.0.20"}`,
+			wantIP:           "10.0.0.20",
+		},
+		"address outside the network": {
+			serverDatacenter: 1,
+			privateIP:        tftypes.NewValue(tftypes.String, "10.0.1.20"),
+			wantErr:          "Invalid Private IP Address",
+		},
+		"server in another datacenter": {
+			serverDatacenter: 2,
+			privateIP:        tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			wantErr:          "Datacenter Mismatch",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var attachBody string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == "GET" && r.URL.Path == "/rest-api/private-networks/7":
+					w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"id":7,"name":"backend","datacenter_id":1,"datacenter_name":"NYC1","cidr":"10.0.0.0/24","servers":[]}}`))
+				case r.Method == "GET" && r.URL.Path == "/rest-api/servers/abc123":
+					fmt.Fprintf(w, `{"statusCode":200,"message":"OK","data":{"id":"abc123","datacenter_id":%d,"datacenter_name":"DC%d"}}`, tt.serverDatacenter, tt.serverDatacenter)
+				case r.Method == "POST" && r.URL.Path == "/rest-api/private-networks/7/servers":
+					body, _ := io.ReadAll(r.Body)
+					attachBody = string(body)
+					ip := "10.0.0.2"
+					if tt.privateIP.IsKnown() {
+						tt.privateIP.As(&ip)
+					}
+					w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"server_id":"abc123","private_ip":"` + ip + `"}}`))
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer server.Close()
+
+			r := &PrivateNetworkAttachmentResource{client: NewICSClient("token", server.URL)}
+			config, plan := testAttachmentValue(t, tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tt.privateIP)
+			resp := resource.CreateResponse{State: tfsdk.State{Schema: config.Schema, Raw: tftypes.NewValue(plan.Type(), nil)}}
+			r.Create(context.Background(), resource.CreateRequest{Config: config, Plan: tfsdk.Plan{Schema: config.Schema, Raw: plan}}, &resp)
+
+			if tt.wantErr != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.wantErr {
+					t.Fatalf("expected %q error, got diagnostics: %v", tt.wantErr, resp.Diagnostics)
+				}
+				if attachBody != "" {
+					t.Errorf("expected no attach request, got %s", attachBody)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if attachBody != tt.wantBody {
+				t.Errorf("expected attach body %s, got %s", tt.wantBody, attachBody)
+			}
+
+			var data PrivateNetworkAttachmentResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+			if data.ID.ValueString() != "7/abc123" || data.PrivateIP.ValueString() != tt.wantIP {
+				t.Errorf("unexpected state: %+v", data)
+			}
+		})
+	}
+}
+
+func TestPrivateNetworkAttachmentFindAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest-api/private-networks/7":
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"id":7,"servers":[{"server_id":"abc123","private_ip":"10.0.0.2"},{"server_id":"def456","private_ip":"10.0.0.3"}]}}`))
+		case "/rest-api/private-networks/8":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"Private network not found"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"statusCode":500,"message":"Server error"}`))
+		}
+	}))
+	defer server.Close()
+
+	r := &PrivateNetworkAttachmentResource{client: NewICSClient("token", server.URL)}
+
+	tests := map[string]struct {
+		networkID int
+		serverID  string
+		wantIP    string
+		wantErr   bool
+	}{
+		"attached":        {networkID: 7, serverID: "def456", wantIP: "10.0.0.3"},
+		"not attached":    {networkID: 7, serverID: "ghi789"},
+		"network removed": {networkID: 8, serverID: "abc123"},
+		"API failure":     {networkID: 9, serverID: "abc123", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			attachment, err := r.findAttachment(context.Background(), tt.networkID, tt.serverID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := ""
+			if attachment != nil {
+				got = attachment.PrivateIP
+			}
+			if got != tt.wantIP {
+				t.Errorf("expected private IP %q, got %q", tt.wantIP, got)
+			}
+		})
+	}
+}
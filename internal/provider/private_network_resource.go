@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PrivateNetworkResource{}
+var _ resource.ResourceWithImportState = &PrivateNetworkResource{}
+var _ resource.ResourceWithValidateConfig = &PrivateNetworkResource{}
+
+func NewPrivateNetworkResource() resource.Resource {
+	return &PrivateNetworkResource{}
+}
+
+// PrivateNetworkResource defines the resource implementation.
+type PrivateNetworkResource struct {
+	client *ICSClient
+}
+
+// PrivateNetworkResourceModel describes the resource data model.
+type PrivateNetworkResourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	DatacenterID types.Int64  `tfsdk:"datacenter_id"`
+	CIDR         types.String `tfsdk:"cidr"`
+
+	// Computed/output fields
+	DatacenterName types.String `tfsdk:"datacenter_name"`
+	VlanID         types.Int64  `tfsdk:"vlan_id"`
+}
+
+func (r *PrivateNetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_network"
+}
+
+func (r *PrivateNetworkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Private network (VLAN) for server-to-server traffic within a datacenter",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Private network identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the private network. Can be updated in-place.",
+				Required:            true,
+			},
+			"datacenter_id": schema.Int64Attribute{
+				MarkdownDescription: "Datacenter to create the network in (e.g., the `datacenter_id` of an `ics_bare_metal_server`). Only servers in this datacenter can be attached.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				MarkdownDescription: "Private address range in CIDR notation (e.g., '10.0.0.0/24'). Assigned by ICS if omitted.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datacenter_name": schema.StringAttribute{
+				MarkdownDescription: "Datacenter name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vlan_id": schema.Int64Attribute{
+				MarkdownDescription: "VLAN tag assigned to the network",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PrivateNetworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PrivateNetworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cidr types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cidr"), &cidr)...)
+
+	if cidr.IsNull() || cidr.IsUnknown() {
+		return
+	}
+
+	ip, _, err := net.ParseCIDR(cidr.ValueString())
+	if err != nil || !ip.IsPrivate() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cidr"),
+			"Invalid CIDR",
+			fmt.Sprintf("%q is not a private address range in CIDR notation.", cidr.ValueString()),
+		)
+	}
+}
+
+func (r *PrivateNetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PrivateNetworkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := PrivateNetworkRequest{
+		Name:         data.Name.ValueString(),
+		DatacenterID: int(data.DatacenterID.ValueInt64()),
+	}
+	if !data.CIDR.IsUnknown() && !data.CIDR.IsNull() {
+		createReq.CIDR = data.CIDR.ValueString()
+	}
+
+	tflog.Info(ctx, "Creating private network", map[string]interface{}{
+		"name":          createReq.Name,
+		"datacenter_id": createReq.DatacenterID,
+	})
+
+	network, err := r.client.CreatePrivateNetwork(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create private network, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromNetwork(&data, network)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivateNetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PrivateNetworkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := int(data.ID.ValueInt64())
+	network, err := r.client.GetPrivateNetwork(ctx, networkID)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Private network no longer exists, removing from state", map[string]interface{}{
+			"id": networkID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private network %d, got error: %s", networkID, err))
+		return
+	}
+
+	r.updateModelFromNetwork(&data, network)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivateNetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PrivateNetworkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the name can change in place
+	networkID := int(data.ID.ValueInt64())
+	err := r.client.RenamePrivateNetwork(ctx, networkID, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename private network %d, got error: %s", networkID, err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivateNetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PrivateNetworkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := int(data.ID.ValueInt64())
+	err := r.client.DeletePrivateNetwork(ctx, networkID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete private network %d, got error: %s", networkID, err))
+		return
+	}
+
+	tflog.Info(ctx, "Private network deleted successfully", map[string]interface{}{
+		"id": networkID,
+	})
+}
+
+func (r *PrivateNetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by network ID
+	networkID, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid private network ID format: %s", req.ID))
+		return
+	}
+
+	network, err := r.client.GetPrivateNetwork(ctx, networkID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read private network %d: %s", networkID, err))
+		return
+	}
+
+	var data PrivateNetworkResourceModel
+	r.updateModelFromNetwork(&data, network)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateModelFromNetwork updates the Terraform model with private network data
+func (r *PrivateNetworkResource) updateModelFromNetwork(data *PrivateNetworkResourceModel, network *PrivateNetwork) {
+	data.ID = types.Int64Value(int64(network.ID))
+	data.Name = types.StringValue(network.Name)
+	data.DatacenterID = types.Int64Value(int64(network.DatacenterID))
+	data.CIDR = types.StringValue(network.CIDR)
+	data.DatacenterName = types.StringValue(network.DatacenterName)
+	data.VlanID = types.Int64Value(int64(network.VlanID))
+}
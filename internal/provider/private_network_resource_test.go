@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPrivateNetworkValidateConfig(t *testing.T) {
+	var schemaResp resource.SchemaResponse
+	NewPrivateNetworkResource().Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	tests := map[string]struct {
+		cidr    tftypes.Value
+		wantErr bool
+	}{
+		"unknown":           {cidr: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+		"10/8":              {cidr: tftypes.NewValue(tftypes.String, "10.20.0.0/16")},
+		"172.16/12":         {cidr: tftypes.NewValue(tftypes.String, "172.16.0.0/24")},
+		"192.168/16":        {cidr: tftypes.NewValue(tftypes.String, "192.168.10.0/24")},
+		"unique local IPv6": {cidr: tftypes.NewValue(tftypes.String, "fd12:3456::/64")},
+		"public IPv4":       {cidr: tftypes.NewValue(tftypes.String, "203.0.113.0/24"), wantErr: true},
+		"public IPv6":       {cidr: tftypes.NewValue(tftypes.String, "2001:db8::/64"), wantErr: true},
+		"bare address":      {cidr: tftypes.NewValue(tftypes.String, "10.0.0.0"), wantErr: true},
+		"not a CIDR":        {cidr: tftypes.NewValue(tftypes.String, "backend"), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for key, attrType := range objectType.AttributeTypes {
+				values[key] = tftypes.NewValue(attrType, nil)
+			}
+			values["cidr"] = tt.cidr
+
+			req := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}
+			var resp resource.ValidateConfigResponse
+			(&PrivateNetworkResource{}).ValidateConfig(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("expected error %t, got diagnostics: %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
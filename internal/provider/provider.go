@@ -2,17 +2,25 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure ICSProvider satisfies various provider interfaces.
 var _ provider.Provider = &ICSProvider{}
+var _ provider.ProviderWithEphemeralResources = &ICSProvider{}
+var _ provider.ProviderWithFunctions = &ICSProvider{}
 
 // ICSProvider defines the provider implementation.
 type ICSProvider struct {
@@ -24,8 +32,25 @@ type ICSProvider struct {
 
 // ICSProviderModel describes the provider data model.
 type ICSProviderModel struct {
-	APIToken types.String `tfsdk:"api_token"`
-	BaseURL  types.String `tfsdk:"base_url"`
+	APIToken        types.String `tfsdk:"api_token"`
+	APITokenFile    types.String `tfsdk:"api_token_file"`
+	BaseURL         types.String `tfsdk:"base_url"`
+	Profile         types.String `tfsdk:"profile"`
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+
+	LogHTTPBodies           types.Bool `tfsdk:"log_http_bodies"`
+	VerifyToken             types.Bool `tfsdk:"verify_token"`
+	WarnOnServerReplacement types.Bool `tfsdk:"warn_on_server_replacement"`
+	DefaultTags             types.Map  `tfsdk:"default_tags"`
 }
 
 func (p *ICSProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -41,8 +66,70 @@ func (p *ICSProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the API token, such as a mounted secret. Can also be set via the ICS_API_TOKEN_FILE environment variable.",
+				Optional:            true,
+			},
 			"base_url": schema.StringAttribute{
-				MarkdownDescription: "The base URL for the ICS API. Defaults to https://api.ingenuitycloudservices.com",
+				MarkdownDescription: "The base URL for the ICS API. Can also be set via the ICS_BASE_URL environment variable. Defaults to https://api.ingenuitycloudservices.com",
+				Optional:            true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Name of the credentials file profile to use. Can also be set via the ICS_PROFILE environment variable. Defaults to `default` if the credentials file exists.",
+				Optional:            true,
+			},
+			"credentials_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the credentials file containing named profiles. Can also be set via the ICS_CREDENTIALS_FILE environment variable. Defaults to `~/.ics/credentials`.",
+				Optional:            true,
+			},
+			"default_tags": schema.MapAttribute{
+				MarkdownDescription: "Tags applied to every bare metal server managed by this provider. Tags set on a server take precedence over default tags with the same key. Must be known at plan time.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of an HTTP proxy for API requests, e.g. `http://proxy.example.com:3128`. Defaults to the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA bundle trusted in addition to the system roots, e.g. for a TLS intercepting proxy with a private CA.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA bundle trusted in addition to the system roots. May be combined with `ca_cert_file`.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the API server's TLS certificate. Only intended for local test stand-ins of the API. Defaults to false.",
+				Optional:            true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded client certificate for mutual TLS. Requires `client_key_file` or `client_key_pem`.",
+				Optional:            true,
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the PEM encoded private key of the client certificate.",
+				Optional:            true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS, as an alternative to `client_cert_file`.",
+				Optional:            true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate, as an alternative to `client_key_file`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"log_http_bodies": schema.BoolAttribute{
+				MarkdownDescription: "Include full API request and response bodies in TRACE level logs. Passwords, user data, console URLs and SSH public keys are masked, but bodies may still contain other account details, so only enable this while debugging. Defaults to false.",
+				Optional:            true,
+			},
+			"verify_token": schema.BoolAttribute{
+				MarkdownDescription: "Check the API token against the ICS API when the provider is configured, so an invalid or revoked token fails fast with a clear error instead of at the first resource call. Defaults to false.",
+				Optional:            true,
+			},
+			"warn_on_server_replacement": schema.BoolAttribute{
+				MarkdownDescription: "Emit a warning during plan whenever a bare metal server would be cancelled and re-ordered because of a change that forces replacement. Defaults to false.",
 				Optional:            true,
 			},
 		},
@@ -58,39 +145,132 @@ func (p *ICSProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
-	// Configuration values are now available.
-	// Example code for configuration validation and default value setting.
-
-	// If api_token is not provided in configuration, use environment variable
-	apiToken := data.APIToken.ValueString()
-	if apiToken == "" {
-		apiToken = os.Getenv("ICS_API_TOKEN")
+	// Resolve the API token and base URL from the configuration, environment
+	// and credentials file
+	creds, err := resolveCredentials(credentialSettings{
+		APIToken:        data.APIToken.ValueString(),
+		APITokenFile:    data.APITokenFile.ValueString(),
+		BaseURL:         data.BaseURL.ValueString(),
+		Profile:         data.Profile.ValueString(),
+		CredentialsFile: data.CredentialsFile.ValueString(),
+	}, os.Getenv)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Credentials Configuration",
+			fmt.Sprintf("While configuring the provider, the credentials could not be loaded: %s\n\n%s", err, credentialPrecedence),
+		)
+		return
 	}
 
-	if apiToken == "" {
+	if creds.APIToken == "" {
 		resp.Diagnostics.AddError(
 			"Missing API Token Configuration",
-			"While configuring the provider, the API token was not found in the configuration or ICS_API_TOKEN environment variable.",
+			"While configuring the provider, no API token was found.\n\n"+credentialPrecedence,
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Configuring ICS client", map[string]interface{}{
+		"token_source": creds.TokenSource,
+		"base_url":     creds.BaseURL,
+	})
+
+	transport, err := newHTTPTransport(transportSettings{
+		ProxyURL:           data.ProxyURL.ValueString(),
+		CACertFile:         data.CACertFile.ValueString(),
+		CACertPEM:          data.CACertPEM.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		ClientCertFile:     data.ClientCertFile.ValueString(),
+		ClientKeyFile:      data.ClientKeyFile.ValueString(),
+		ClientCertPEM:      data.ClientCertPEM.ValueString(),
+		ClientKeyPEM:       data.ClientKeyPEM.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid HTTP Transport Configuration",
+			fmt.Sprintf("While configuring the provider, the HTTP transport could not be set up: %s", err),
 		)
 		return
 	}
 
-	baseURL := data.BaseURL.ValueString()
-	if baseURL == "" {
-		baseURL = "https://api.ingenuitycloudservices.com"
+	if data.InsecureSkipVerify.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"TLS Verification Disabled",
+			"insecure_skip_verify is set, so the API server's certificate is not verified. Only use this with local test stand-ins of the API.",
+		)
 	}
 
 	// Create properly initialized client for data sources and resources
-	client := NewICSClient(apiToken, baseURL)
+	client := NewICSClient(creds.APIToken, creds.BaseURL)
+	client.HTTPClient.Transport = transport
+	client.UserAgent = userAgent(p.version, req.TerraformVersion)
+	client.LogBodies = data.LogHTTPBodies.ValueBool()
+	client.WarnOnServerReplacement = data.WarnOnServerReplacement.ValueBool()
+
+	if data.VerifyToken.ValueBool() {
+		account, err := client.GetAccount(ctx)
+		if errors.Is(err, ErrUnauthorized) {
+			resp.Diagnostics.AddError(
+				"Invalid API Token",
+				fmt.Sprintf("The API token from %s was rejected by the ICS API at %s. Check that the token is correct and has not been revoked.\n\n%s", creds.TokenSource, creds.BaseURL, credentialPrecedence),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Verify API Token",
+				fmt.Sprintf("While configuring the provider, the API token could not be verified, got error: %s", err),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Verified ICS API token", map[string]interface{}{
+			"account_id":   account.ID,
+			"account_name": account.Name,
+		})
+	}
+
+	// Default tags are merged into every plan, so they must be known when the
+	// provider is configured
+	defaultTagsUnknown := data.DefaultTags.IsUnknown()
+	for _, value := range data.DefaultTags.Elements() {
+		if value.IsUnknown() {
+			defaultTagsUnknown = true
+		}
+	}
+	if defaultTagsUnknown {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_tags"),
+			"Unknown Default Tags",
+			"The provider cannot be configured because default_tags depends on values that are not known until apply. "+
+				"Set default_tags from values known at plan time, such as variables or locals, or apply the resources they depend on first with -target.",
+		)
+		return
+	}
+
+	if !data.DefaultTags.IsNull() {
+		resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &client.DefaultTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *ICSProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewBareMetalServerResource,
 		NewSSHKeyResource,
+		NewServerSSHKeyAttachmentResource,
+		NewServerPasswordRotationResource,
+		NewReverseDNSResource,
+		NewIPAllocationResource,
+		NewPrivateNetworkResource,
+		NewPrivateNetworkAttachmentResource,
+		NewFirewallResource,
 	}
 }
 
@@ -98,6 +278,28 @@ func (p *ICSProvider) DataSources(ctx context.Context) []func() datasource.DataS
 	return []func() datasource.DataSource{
 		NewInventoryDataSource,
 		NewOperatingSystemsDataSource,
+		NewServersDataSource,
+		NewFirewallDataSource,
+		NewServerBandwidthDataSource,
+		NewBillingSummaryDataSource,
+		NewLocationsDataSource,
+		NewAccountDataSource,
+	}
+}
+
+func (p *ICSProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewServerRootPasswordEphemeralResource,
+		NewServerConsoleAccessEphemeralResource,
+	}
+}
+
+func (p *ICSProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewSKUMatchesFunction,
+		NewMonthlyCostFunction,
+		NewParsePriceFunction,
+		NewSSHFingerprintFunction,
 	}
 }
 
@@ -1,7 +1,13 @@
 package provider
 
 import (
+	"context"
+	"path/filepath"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestAccProvider(t *testing.T) {
@@ -11,4 +17,73 @@ func TestAccProvider(t *testing.T) {
 	if provider == nil {
 		t.Fatal("Expected provider to be instantiated")
 	}
-}
\ No newline at end of file
+}
+
+func TestConfigureDefaultTags(t *testing.T) {
+	t.Setenv("ICS_API_TOKEN", "token")
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("ICS_PROFILE", "")
+
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(context.Background(), provider.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	tagsType := tftypes.Map{ElementType: tftypes.String}
+
+	tests := map[string]struct {
+		defaultTags tftypes.Value
+		wantError   bool
+		wantTags    map[string]string
+	}{
+		"null": {
+			defaultTags: tftypes.NewValue(tagsType, nil),
+		},
+		"known": {
+			defaultTags: tftypes.NewValue(tagsType, map[string]tftypes.Value{"team": tftypes.NewValue(tftypes.String, "infra")}),
+			wantTags:    map[string]string{"team": "infra"},
+		},
+		"unknown map": {
+			defaultTags: tftypes.NewValue(tagsType, tftypes.UnknownValue),
+			wantError:   true,
+		},
+		"unknown value": {
+			defaultTags: tftypes.NewValue(tagsType, map[string]tftypes.Value{"team": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)}),
+			wantError:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for key, attrType := range objectType.AttributeTypes {
+				values[key] = tftypes.NewValue(attrType, nil)
+			}
+			values["default_tags"] = tt.defaultTags
+			values["credentials_file"] = tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "missing"))
+
+			req := provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}
+			var resp provider.ConfigureResponse
+			p.Configure(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("expected error %t, got: %v", tt.wantError, resp.Diagnostics)
+			}
+			if tt.wantError {
+				return
+			}
+
+			client := resp.ResourceData.(*ICSClient)
+			if len(client.DefaultTags) != len(tt.wantTags) {
+				t.Fatalf("expected default tags %v, got %v", tt.wantTags, client.DefaultTags)
+			}
+			for key, value := range tt.wantTags {
+				if client.DefaultTags[key] != value {
+					t.Errorf("expected %s=%s, got %s", key, value, client.DefaultTags[key])
+				}
+			}
+		})
+	}
+}
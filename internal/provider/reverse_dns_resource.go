@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ReverseDNSResource{}
+var _ resource.ResourceWithImportState = &ReverseDNSResource{}
+var _ resource.ResourceWithValidateConfig = &ReverseDNSResource{}
+
+func NewReverseDNSResource() resource.Resource {
+	return &ReverseDNSResource{}
+}
+
+// ReverseDNSResource defines the resource implementation.
+type ReverseDNSResource struct {
+	client *ICSClient
+}
+
+// ReverseDNSResourceModel describes the resource data model.
+type ReverseDNSResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	IPAddress types.String `tfsdk:"ip_address"`
+	Hostname  types.String `tfsdk:"hostname"`
+	ServerID  types.String `tfsdk:"server_id"`
+}
+
+func (r *ReverseDNSResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reverse_dns"
+}
+
+func (r *ReverseDNSResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reverse DNS (PTR) record for an IP address assigned to one of your servers",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Reverse DNS identifier (the IP address in canonical form, e.g. IPv6 addresses compressed and lower case)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "IP address to set the PTR record for. Must belong to one of your servers.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						ipAddressChanged,
+						"Changing to a different IP address requires replacement; another spelling of the same address does not.",
+						"Changing to a different IP address requires replacement; another spelling of the same address does not.",
+					),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Fully qualified hostname the IP address resolves to (e.g., 'mail.example.com')",
+				Required:            true,
+			},
+			"server_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the server the IP address is assigned to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ReverseDNSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ReverseDNSResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var ipAddress types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ip_address"), &ipAddress)...)
+
+	if ipAddress.IsNull() || ipAddress.IsUnknown() {
+		return
+	}
+
+	if net.ParseIP(ipAddress.ValueString()) == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ip_address"),
+			"Invalid IP Address",
+			fmt.Sprintf("%q is not a valid IPv4 or IPv6 address.", ipAddress.ValueString()),
+		)
+	}
+}
+
+func (r *ReverseDNSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReverseDNSResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipAddress := normalizeIP(data.IPAddress.ValueString())
+	hostname := data.Hostname.ValueString()
+
+	// Only IPs assigned to our own servers can have their PTR record changed
+	server, err := r.findServerByIP(ctx, ipAddress)
+	if err != nil {
+		resp.Diagnostics.AddError("IP Address Not Found", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Setting reverse DNS", map[string]interface{}{
+		"ip_address": ipAddress,
+		"hostname":   hostname,
+		"server_id":  server.ID,
+	})
+
+	err = r.client.SetReverseDNS(ctx, ipAddress, hostname)
+	if err != nil {
+		resp.Diagnostics.AddError("Reverse DNS Update Failed", fmt.Sprintf("Unable to set reverse DNS for %s: %s", ipAddress, err))
+		return
+	}
+
+	data.ID = types.StringValue(ipAddress)
+	data.ServerID = types.StringValue(server.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReverseDNSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ReverseDNSResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipAddress := normalizeIP(data.IPAddress.ValueString())
+	reverseDNS, err := r.client.GetReverseDNS(ctx, ipAddress)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "IP address no longer exists, removing reverse DNS from state", map[string]interface{}{
+			"ip_address": ipAddress,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read reverse DNS for %s, got error: %s", ipAddress, err))
+		return
+	}
+
+	// The PTR record was reset outside Terraform
+	if reverseDNS.Hostname == "" {
+		tflog.Warn(ctx, "Reverse DNS record no longer exists, removing from state", map[string]interface{}{
+			"ip_address": ipAddress,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// PTR hostnames are case-insensitive and may be reported with a trailing dot
+	if !strings.EqualFold(strings.TrimSuffix(reverseDNS.Hostname, "."), strings.TrimSuffix(data.Hostname.ValueString(), ".")) {
+		data.Hostname = types.StringValue(reverseDNS.Hostname)
+	}
+
+	// IDs saved before addresses were normalized may use another IPv6 form
+	data.ID = types.StringValue(ipAddress)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReverseDNSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ReverseDNSResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ip_address only changes in place when respelled, so it is the same address
+	ipAddress := normalizeIP(data.IPAddress.ValueString())
+	hostname := data.Hostname.ValueString()
+
+	tflog.Info(ctx, "Updating reverse DNS", map[string]interface{}{
+		"ip_address": ipAddress,
+		"hostname":   hostname,
+	})
+
+	err := r.client.SetReverseDNS(ctx, ipAddress, hostname)
+	if err != nil {
+		resp.Diagnostics.AddError("Reverse DNS Update Failed", fmt.Sprintf("Unable to set reverse DNS for %s: %s", ipAddress, err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReverseDNSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ReverseDNSResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipAddress := normalizeIP(data.IPAddress.ValueString())
+	err := r.client.DeleteReverseDNS(ctx, ipAddress)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete reverse DNS for %s, got error: %s", ipAddress, err))
+		return
+	}
+
+	tflog.Info(ctx, "Reverse DNS deleted successfully", map[string]interface{}{
+		"ip_address": ipAddress,
+	})
+}
+
+func (r *ReverseDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by IP address
+	if net.ParseIP(req.ID) == nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid IP address format: %s", req.ID))
+		return
+	}
+	ipAddress := normalizeIP(req.ID)
+
+	server, err := r.findServerByIP(ctx, ipAddress)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", err.Error())
+		return
+	}
+
+	reverseDNS, err := r.client.GetReverseDNS(ctx, ipAddress)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read reverse DNS for %s: %s", ipAddress, err))
+		return
+	}
+
+	// Keep the address as written so a configuration using the same spelling
+	// plans no changes; the ID is always the canonical form
+	data := ReverseDNSResourceModel{
+		ID:        types.StringValue(ipAddress),
+		IPAddress: types.StringValue(req.ID),
+		Hostname:  types.StringValue(reverseDNS.Hostname),
+		ServerID:  types.StringValue(server.ID),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findServerByIP returns the server that the IP address is assigned to
+func (r *ReverseDNSResource) findServerByIP(ctx context.Context, ipAddress string) (*Server, error) {
+	servers, err := r.client.GetServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list servers: %w", err)
+	}
+
+	ip := net.ParseIP(ipAddress)
+	for _, server := range servers {
+		if ip.Equal(net.ParseIP(server.PublicIP)) {
+			return &server, nil
+		}
+		for _, address := range server.IPAddresses {
+			if ip.Equal(net.ParseIP(address.Address)) {
+				return &server, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("IP address %s is not assigned to any of your servers", ipAddress)
+}
+
+// ipAddressChanged requires replacement only when ip_address refers to a
+// different address, not when the same address is spelled differently (for
+// example after importing "2001:DB8::1" and configuring "2001:db8::1").
+func ipAddressChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = normalizeIP(req.StateValue.ValueString()) != normalizeIP(req.PlanValue.ValueString())
+}
+
+// normalizeIP returns the canonical form of an IP address, so that different
+// spellings of the same IPv6 address share one ID. Invalid addresses are
+// returned unchanged.
+func normalizeIP(ipAddress string) string {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return ipAddress
+	}
+
+	return addr.String()
+}
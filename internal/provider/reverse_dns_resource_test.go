@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNormalizeIP(t *testing.T) {
+	tests := map[string]string{
+		"192.0.2.10":  "192.0.2.10",
+		"2001:DB8::1": "2001:db8::1",
+		"2001:0db8:0000:0000:0000:0000:0000:0001": "2001:db8::1",
+		"2001:db8:0:0:1:0:0:1":                    "2001:db8::1:0:0:1",
+		"::ffff:192.0.2.10":                       "::ffff:192.0.2.10",
+		"not an address":                          "not an address",
+	}
+
+	for input, want := range tests {
+		if got := normalizeIP(input); got != want {
+			t.Errorf("normalizeIP(%q): expected %q, got %q", input, want, got)
+		}
+	}
+}
+
+func TestReverseDNSRead(t *testing.T) {
+	var requestedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		switch r.URL.Path {
+		case "/rest-api/reverse-dns/2001:db8::1":
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"ip_address":"2001:db8::1","hostname":"MAIL.example.com."}}`))
+		case "/rest-api/reverse-dns/192.0.2.10":
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"ip_address":"192.0.2.10","hostname":""}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"IP address not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	r := &ReverseDNSResource{client: NewICSClient("token", server.URL)}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	tests := map[string]struct {
+		ipAddress   string
+		wantPath    string
+		wantRemoved bool
+		wantID      string
+	}{
+		"IPv6 normalized": {
+			ipAddress: "2001:0DB8:0:0:0:0:0:1",
+			wantPath:  "/rest-api/reverse-dns/2001:db8::1",
+			wantID:    "2001:db8::1",
+		},
+		"record reset": {
+			ipAddress:   "192.0.2.10",
+			wantPath:    "/rest-api/reverse-dns/192.0.2.10",
+			wantRemoved: true,
+		},
+		"address not found": {
+			ipAddress:   "192.0.2.99",
+			wantPath:    "/rest-api/reverse-dns/192.0.2.99",
+			wantRemoved: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":         tftypes.NewValue(tftypes.String, tt.ipAddress),
+					"ip_address": tftypes.NewValue(tftypes.String, tt.ipAddress),
+					"hostname":   tftypes.NewValue(tftypes.String, "mail.example.com"),
+					"server_id":  tftypes.NewValue(tftypes.String, "abc123"),
+				}),
+			}
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if requestedPath != tt.wantPath {
+				t.Errorf("expected request to %s, got %s", tt.wantPath, requestedPath)
+			}
+
+			if removed := resp.State.Raw.IsNull(); removed != tt.wantRemoved {
+				t.Fatalf("expected removed %t, got %t", tt.wantRemoved, removed)
+			}
+			if tt.wantRemoved {
+				return
+			}
+
+			var data ReverseDNSResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+			if data.ID != types.StringValue(tt.wantID) {
+				t.Errorf("expected ID %s, got %s", tt.wantID, data.ID)
+			}
+			// Equivalent hostnames keep the configured spelling
+			if data.Hostname.ValueString() != "mail.example.com" {
+				t.Errorf("expected hostname to be unchanged, got %s", data.Hostname)
+			}
+		})
+	}
+}
+
+func TestIPAddressChanged(t *testing.T) {
+	tests := map[string]struct {
+		state, plan string
+		want        bool
+	}{
+		"same spelling":       {"2001:db8::1", "2001:db8::1", false},
+		"different spelling":  {"2001:DB8::1", "2001:0db8:0:0:0:0:0:1", false},
+		"different address":   {"2001:db8::1", "2001:db8::2", true},
+		"different IPv4":      {"192.0.2.10", "192.0.2.11", true},
+		"IPv4 to IPv6 mapped": {"192.0.2.10", "::ffff:192.0.2.10", true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				StateValue: types.StringValue(tt.state),
+				PlanValue:  types.StringValue(tt.plan),
+			}
+			var resp stringplanmodifier.RequiresReplaceIfFuncResponse
+			ipAddressChanged(context.Background(), req, &resp)
+			if resp.RequiresReplace != tt.want {
+				t.Errorf("expected requires replace %t, got %t", tt.want, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+func TestReverseDNSImportState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest-api/servers":
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":[{"id":"abc123","service_id":1001,"public_ip":"2001:db8::1"}]}`))
+		case "/rest-api/reverse-dns/2001:db8::1":
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"ip_address":"2001:db8::1","hostname":"mail.example.com"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &ReverseDNSResource{client: NewICSClient("token", server.URL)}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	resp := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "2001:DB8::1"}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data ReverseDNSResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if data.ID != types.StringValue("2001:db8::1") {
+		t.Errorf("expected canonical ID, got %s", data.ID)
+	}
+	if data.IPAddress != types.StringValue("2001:DB8::1") {
+		t.Errorf("expected ip_address as written in the import ID, got %s", data.IPAddress)
+	}
+	if data.ServerID != types.StringValue("abc123") {
+		t.Errorf("expected server ID abc123, got %s", data.ServerID)
+	}
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ServerBandwidthDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ServerBandwidthDataSource{}
+
+func NewServerBandwidthDataSource() datasource.DataSource {
+	return &ServerBandwidthDataSource{}
+}
+
+// ServerBandwidthDataSource defines the data source implementation.
+type ServerBandwidthDataSource struct {
+	client *ICSClient
+}
+
+// ServerBandwidthDataSourceModel describes the data source data model.
+type ServerBandwidthDataSourceModel struct {
+	ServerID             types.String  `tfsdk:"server_id"`
+	Start                types.String  `tfsdk:"start"`
+	End                  types.String  `tfsdk:"end"`
+	ID                   types.String  `tfsdk:"id"`
+	PeriodStart          types.String  `tfsdk:"period_start"`
+	PeriodEnd            types.String  `tfsdk:"period_end"`
+	InboundBytes         types.Int64   `tfsdk:"inbound_bytes"`
+	OutboundBytes        types.Int64   `tfsdk:"outbound_bytes"`
+	TotalBytes           types.Int64   `tfsdk:"total_bytes"`
+	Percentile95thMbps   types.Float64 `tfsdk:"percentile_95th_mbps"`
+	AllowanceBytes       types.Int64   `tfsdk:"allowance_bytes"`
+	AllowanceUsedPercent types.Float64 `tfsdk:"allowance_used_percent"`
+}
+
+func (d *ServerBandwidthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_bandwidth"
+}
+
+func (d *ServerBandwidthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Server bandwidth data source provides traffic usage of a bare metal server over a time window.",
+
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				MarkdownDescription: "Server identifier (the `id` of an `ics_bare_metal_server`)",
+				Required:            true,
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "Start of the time window (RFC3339). Defaults to the start of the current billing period.",
+				Optional:            true,
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "End of the time window (RFC3339). Defaults to now.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"period_start": schema.StringAttribute{
+				MarkdownDescription: "Start of the time window the usage covers (RFC3339)",
+				Computed:            true,
+			},
+			"period_end": schema.StringAttribute{
+				MarkdownDescription: "End of the time window the usage covers (RFC3339)",
+				Computed:            true,
+			},
+			"inbound_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Bytes received by the server",
+				Computed:            true,
+			},
+			"outbound_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Bytes sent by the server",
+				Computed:            true,
+			},
+			"total_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Bytes received and sent by the server",
+				Computed:            true,
+			},
+			"percentile_95th_mbps": schema.Float64Attribute{
+				MarkdownDescription: "95th percentile throughput in Mbps",
+				Computed:            true,
+			},
+			"allowance_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Traffic included in the server's plan for the billing period. Null if the server is unmetered.",
+				Computed:            true,
+			},
+			"allowance_used_percent": schema.Float64Attribute{
+				MarkdownDescription: "`total_bytes` as a percentage of `allowance_bytes`. Null if the server is unmetered.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ServerBandwidthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServerBandwidthDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data ServerBandwidthDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var start, end time.Time
+	for _, bound := range []struct {
+		name  string
+		value types.String
+		time  *time.Time
+	}{
+		{"start", data.Start, &start},
+		{"end", data.End, &end},
+	} {
+		if bound.value.IsNull() || bound.value.IsUnknown() {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, bound.value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(bound.name),
+				"Invalid Timestamp",
+				fmt.Sprintf("%s must be an RFC3339 timestamp (e.g., '2025-01-01T00:00:00Z'), got: %q", bound.name, bound.value.ValueString()),
+			)
+			continue
+		}
+		*bound.time = t
+	}
+
+	if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end"),
+			"Invalid Time Window",
+			"end must be after start.",
+		)
+	}
+}
+
+func (d *ServerBandwidthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServerBandwidthDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Timestamps were checked in ValidateConfig
+	var start, end time.Time
+	if !data.Start.IsNull() {
+		start, _ = time.Parse(time.RFC3339, data.Start.ValueString())
+	}
+	if !data.End.IsNull() {
+		end, _ = time.Parse(time.RFC3339, data.End.ValueString())
+	}
+
+	serverID := data.ServerID.ValueString()
+	usage, err := d.client.GetServerBandwidth(ctx, serverID, start, end)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read bandwidth for server %s, got error: %s", serverID, err))
+		return
+	}
+
+	totalBytes := usage.InboundBytes + usage.OutboundBytes
+
+	data.ID = types.StringValue(serverID)
+	data.PeriodStart = types.StringValue(usage.PeriodStart)
+	data.PeriodEnd = types.StringValue(usage.PeriodEnd)
+	data.InboundBytes = types.Int64Value(usage.InboundBytes)
+	data.OutboundBytes = types.Int64Value(usage.OutboundBytes)
+	data.TotalBytes = types.Int64Value(totalBytes)
+	data.Percentile95thMbps = types.Float64Value(usage.Percentile95thMbps)
+	data.AllowanceBytes = types.Int64Null()
+	data.AllowanceUsedPercent = types.Float64Null()
+	if usage.AllowanceBytes > 0 {
+		data.AllowanceBytes = types.Int64Value(usage.AllowanceBytes)
+		data.AllowanceUsedPercent = types.Float64Value(float64(totalBytes) / float64(usage.AllowanceBytes) * 100)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testBandwidthConfig builds a server bandwidth configuration with the given window
+func testBandwidthConfig(t *testing.T, start, end tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	var resp datasource.SchemaResponse
+	NewServerBandwidthDataSource().Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+	objectType := resp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["server_id"] = tftypes.NewValue(tftypes.String, "abc123")
+	values["start"] = start
+	values["end"] = end
+
+	return tfsdk.Config{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, values)}
+}
+
+func TestServerBandwidthValidateConfig(t *testing.T) {
+	null := tftypes.NewValue(tftypes.String, nil)
+	value := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+
+	tests := map[string]struct {
+		start, end tftypes.Value
+		wantErr    bool
+	}{
+		"current period":       {start: null, end: null},
+		"start only":           {start: value("2024-06-01T00:00:00Z"), end: null},
+		"window":               {start: value("2024-06-01T00:00:00Z"), end: value("2024-06-08T00:00:00Z")},
+		"offsets compared":     {start: value("2024-06-01T02:00:00+02:00"), end: value("2024-06-01T01:00:00Z")},
+		"unknown end":          {start: value("2024-06-01T00:00:00Z"), end: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+		"not RFC3339":          {start: value("2024-06-01"), end: null, wantErr: true},
+		"end before start":     {start: value("2024-06-08T00:00:00Z"), end: value("2024-06-01T00:00:00Z"), wantErr: true},
+		"empty window":         {start: value("2024-06-01T00:00:00Z"), end: value("2024-06-01T00:00:00Z"), wantErr: true},
+		"same instant offsets": {start: value("2024-06-01T02:00:00+02:00"), end: value("2024-06-01T00:00:00Z"), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var resp datasource.ValidateConfigResponse
+			(&ServerBandwidthDataSource{}).ValidateConfig(context.Background(), datasource.ValidateConfigRequest{Config: testBandwidthConfig(t, tt.start, tt.end)}, &resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("expected error %t, got diagnostics: %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestServerBandwidthRead(t *testing.T) {
+	tests := map[string]struct {
+		start, end  tftypes.Value
+		allowance   int64
+		wantQuery   string
+		wantPercent float64
+	}{
+		"current period, unmetered": {
+			start:     tftypes.NewValue(tftypes.String, nil),
+			end:       tftypes.NewValue(tftypes.String, nil),
+			wantQuery: "",
+		},
+		"window in UTC, metered": {
+			start:       tftypes.NewValue(tftypes.String, "2024-06-01T02:00:00+02:00"),
+			end:         tftypes.NewValue(tftypes.String, "2024-06-08T00:00:00Z"),
+			allowance:   4000,
+			wantQuery:   "end=2024-06-08T00%3A00%3A00Z&start=2024-06-01T00%3A00%3A00Z",
+			wantPercent: 75,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotQuery string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/rest-api/servers/abc123/bandwidth" {
+					http.NotFound(w, r)
+					return
+				}
+				gotQuery = r.URL.RawQuery
+				fmt.Fprintf(w, `{"statusCode":200,"message":"OK","data":{"server_id":"abc123","period_start":"2024-06-01T00:00:00Z","period_end":"2024-06-08T00:00:00Z","inbound_bytes":1000,"outbound_bytes":2000,"percentile_95th_mbps":12.5,"allowance_bytes":%d}}`, tt.allowance)
+			}))
+			defer server.Close()
+
+			d := &ServerBandwidthDataSource{client: NewICSClient("token", server.URL)}
+			config := testBandwidthConfig(t, tt.start, tt.end)
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: tftypes.NewValue(config.Raw.Type(), nil)}}
+			d.Read(context.Background(), datasource.ReadRequest{Config: config}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if gotQuery != tt.wantQuery {
+				t.Errorf("expected query %q, got %q", tt.wantQuery, gotQuery)
+			}
+
+			var data ServerBandwidthDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+			if data.TotalBytes.ValueInt64() != 3000 || data.ID.ValueString() != "abc123" {
+				t.Errorf("unexpected usage: %+v", data)
+			}
+
+			if tt.allowance == 0 {
+				if !data.AllowanceBytes.IsNull() || !data.AllowanceUsedPercent.IsNull() {
+					t.Errorf("expected null allowance for an unmetered server, got %s and %s", data.AllowanceBytes, data.AllowanceUsedPercent)
+				}
+				return
+			}
+			if data.AllowanceBytes.ValueInt64() != tt.allowance || data.AllowanceUsedPercent.ValueFloat64() != tt.wantPercent {
+				t.Errorf("expected allowance %d at %v%%, got %s at %s", tt.allowance, tt.wantPercent, data.AllowanceBytes, data.AllowanceUsedPercent)
+			}
+		})
+	}
+}
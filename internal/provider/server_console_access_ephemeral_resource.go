@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultConsoleAccessDuration is how long console credentials stay valid when duration is not set
+	defaultConsoleAccessDuration = time.Hour
+	minConsoleAccessDuration     = 5 * time.Minute
+	maxConsoleAccessDuration     = 24 * time.Hour
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ServerConsoleAccessEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ServerConsoleAccessEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &ServerConsoleAccessEphemeralResource{}
+
+func NewServerConsoleAccessEphemeralResource() ephemeral.EphemeralResource {
+	return &ServerConsoleAccessEphemeralResource{}
+}
+
+// ServerConsoleAccessEphemeralResource defines the ephemeral resource implementation.
+type ServerConsoleAccessEphemeralResource struct {
+	client *ICSClient
+}
+
+// ServerConsoleAccessEphemeralResourceModel describes the ephemeral resource data model.
+type ServerConsoleAccessEphemeralResourceModel struct {
+	ServerID   types.String `tfsdk:"server_id"`
+	SourceIP   types.String `tfsdk:"source_ip"`
+	Duration   types.String `tfsdk:"duration"`
+	Host       types.String `tfsdk:"host"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+	ConsoleURL types.String `tfsdk:"console_url"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+func (e *ServerConsoleAccessEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_console_access"
+}
+
+func (e *ServerConsoleAccessEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Requests temporary out-of-band (IPMI/KVM) console credentials for a bare metal server without storing them in state or plan. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				MarkdownDescription: "Server identifier (the `id` of an `ics_bare_metal_server`)",
+				Required:            true,
+			},
+			"source_ip": schema.StringAttribute{
+				MarkdownDescription: "IP address allowed to connect to the console. Connections from any other address are rejected.",
+				Required:            true,
+			},
+			"duration": schema.StringAttribute{
+				MarkdownDescription: "How long the credentials stay valid as a Go duration (e.g., '30m'). Between 5m and 24h, rounded up to whole minutes. Defaults to '1h'.",
+				Optional:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Hostname or IP address of the server's management controller",
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Console username",
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Console password",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"console_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the browser-based KVM console, if available",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Time the credentials expire (RFC3339)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *ServerConsoleAccessEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+func (e *ServerConsoleAccessEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data ServerConsoleAccessEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SourceIP.IsNull() && !data.SourceIP.IsUnknown() && net.ParseIP(data.SourceIP.ValueString()) == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_ip"),
+			"Invalid IP Address",
+			fmt.Sprintf("%q is not a valid IPv4 or IPv6 address.", data.SourceIP.ValueString()),
+		)
+	}
+
+	if !data.Duration.IsNull() && !data.Duration.IsUnknown() {
+		if _, err := consoleAccessMinutes(data.Duration.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("duration"), "Invalid Duration", err.Error())
+		}
+	}
+}
+
+func (e *ServerConsoleAccessEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ServerConsoleAccessEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// duration may have been unknown during validation, so check it again
+	minutes := int(defaultConsoleAccessDuration.Minutes())
+	if !data.Duration.IsNull() {
+		var err error
+		minutes, err = consoleAccessMinutes(data.Duration.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("duration"), "Invalid Duration", err.Error())
+			return
+		}
+	}
+
+	serverID := data.ServerID.ValueString()
+
+	tflog.Info(ctx, "Requesting console access", map[string]interface{}{
+		"server_id":        serverID,
+		"source_ip":        data.SourceIP.ValueString(),
+		"duration_minutes": minutes,
+	})
+
+	access, err := e.client.RequestConsoleAccess(ctx, serverID, ConsoleAccessRequest{
+		SourceIP:        data.SourceIP.ValueString(),
+		DurationMinutes: minutes,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Console Access Failed", fmt.Sprintf("Unable to request console access for server %s: %s", serverID, err))
+		return
+	}
+
+	data.Host = types.StringValue(access.Host)
+	data.Username = types.StringValue(access.Username)
+	data.Password = types.StringValue(access.Password)
+	data.ConsoleURL = types.StringValue(access.ConsoleURL)
+	data.ExpiresAt = types.StringValue(access.ExpiresAt)
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// consoleAccessMinutes parses a console access duration and returns it in
+// whole minutes, rounding up so access never ends before the requested time
+func consoleAccessMinutes(duration string) (int, error) {
+	d, err := time.ParseDuration(duration)
+	if err != nil || d < minConsoleAccessDuration || d > maxConsoleAccessDuration {
+		return 0, fmt.Errorf("duration must be a Go duration between %s and %s, got: %q", minConsoleAccessDuration, maxConsoleAccessDuration, duration)
+	}
+
+	return int(math.Ceil(d.Minutes())), nil
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testConsoleAccessConfig builds a console access configuration with every
+// attribute not in values set to null
+func testConsoleAccessConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	var resp ephemeral.SchemaResponse
+	NewServerConsoleAccessEphemeralResource().Schema(context.Background(), ephemeral.SchemaRequest{}, &resp)
+	objectType := resp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+			continue
+		}
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	return tfsdk.Config{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+func TestServerConsoleAccessValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		sourceIP string
+		duration tftypes.Value
+		wantErr  bool
+	}{
+		"defaults":            {sourceIP: "198.51.100.7", duration: tftypes.NewValue(tftypes.String, nil)},
+		"IPv6 source":         {sourceIP: "2001:db8::7", duration: tftypes.NewValue(tftypes.String, "30m")},
+		"unknown duration":    {sourceIP: "198.51.100.7", duration: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+		"minimum duration":    {sourceIP: "198.51.100.7", duration: tftypes.NewValue(tftypes.String, "5m")},
+		"maximum duration":    {sourceIP: "198.51.100.7", duration: tftypes.NewValue(tftypes.String, "24h")},
+		"invalid source":      {sourceIP: "198.51.100.0/24", duration: tftypes.NewValue(tftypes.String, nil), wantErr: true},
+		"duration too short":  {sourceIP: "198.51.100.7", duration: tftypes.NewValue(tftypes.String, "1m"), wantErr: true},
+		"duration too long":   {sourceIP: "198.51.100.7", duration: tftypes.NewValue(tftypes.String, "25h"), wantErr: true},
+		"unparsable duration": {sourceIP: "198.51.100.7", duration: tftypes.NewValue(tftypes.String, "an hour"), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := testConsoleAccessConfig(t, map[string]tftypes.Value{
+				"server_id": tftypes.NewValue(tftypes.String, "abc123"),
+				"source_ip": tftypes.NewValue(tftypes.String, tt.sourceIP),
+				"duration":  tt.duration,
+			})
+
+			var resp ephemeral.ValidateConfigResponse
+			(&ServerConsoleAccessEphemeralResource{}).ValidateConfig(context.Background(), ephemeral.ValidateConfigRequest{Config: config}, &resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("expected error %t, got diagnostics: %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestServerConsoleAccessOpen(t *testing.T) {
+	var requestBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest-api/servers/abc123/console-access" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"Server not found"}`))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		requestBody = string(body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"statusCode":201,"message":"Created","data":{"host":"ipmi-abc123.example.com","username":"console","password":"s3cret","console_url":"https://console.example.com/?token=abc","expires_at":"2024-06-01T13:00:00Z"}}`))
+	}))
+	defer server.Close()
+
+	e := &ServerConsoleAccessEphemeralResource{client: NewICSClient("token", server.URL)}
+
+	open := func(serverID string, duration tftypes.Value) ephemeral.OpenResponse {
+		config := testConsoleAccessConfig(t, map[string]tftypes.Value{
+			"server_id": tftypes.NewValue(tftypes.String, serverID),
+			"source_ip": tftypes.NewValue(tftypes.String, "198.51.100.7"),
+			"duration":  duration,
+		})
+		resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: config.Schema, Raw: tftypes.NewValue(config.Raw.Type(), nil)}}
+		e.Open(context.Background(), ephemeral.OpenRequest{Config: config}, &resp)
+		return resp
+	}
+
+	resp := open("abc123", tftypes.NewValue(tftypes.String, nil))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if requestBody != `{"source_ip":"198.51.100.7","duration_minutes":60}` {
+		t.Errorf("expected the default duration, got body %s", requestBody)
+	}
+
+	var data ServerConsoleAccessEphemeralResourceModel
+	resp.Diagnostics.Append(resp.Result.Get(context.Background(), &data)...)
+	if data.Host.ValueString() != "ipmi-abc123.example.com" || data.Password.ValueString() != "s3cret" ||
+		data.ConsoleURL.ValueString() != "https://console.example.com/?token=abc" || data.ExpiresAt.ValueString() != "2024-06-01T13:00:00Z" {
+		t.Errorf("unexpected result: %+v", data)
+	}
+
+	if resp := open("abc123", tftypes.NewValue(tftypes.String, "1h30m")); resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if requestBody != `{"source_ip":"198.51.100.7","duration_minutes":90}` {
+		t.Errorf("expected a 90 minute duration, got body %s", requestBody)
+	}
+
+	// Partial minutes are rounded up rather than cutting access short
+	if resp := open("abc123", tftypes.NewValue(tftypes.String, "90m30s")); resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if requestBody != `{"source_ip":"198.51.100.7","duration_minutes":91}` {
+		t.Errorf("expected a 91 minute duration, got body %s", requestBody)
+	}
+
+	// A duration that was unknown during validation is checked before requesting access
+	requestBody = ""
+	if resp := open("abc123", tftypes.NewValue(tftypes.String, "an hour")); !resp.Diagnostics.HasError() {
+		t.Error("expected an error for an invalid duration")
+	}
+	if requestBody != "" {
+		t.Errorf("expected no request for an invalid duration, got body %s", requestBody)
+	}
+
+	if resp := open("missing", tftypes.NewValue(tftypes.String, nil)); !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a missing server")
+	}
+}
+
+func TestConsoleAccessMinutes(t *testing.T) {
+	tests := map[string]struct {
+		duration string
+		want     int
+		wantErr  bool
+	}{
+		"whole minutes":  {duration: "1h30m", want: 90},
+		"partial minute": {duration: "90m30s", want: 91},
+		"one second":     {duration: "5m1s", want: 6},
+		"minimum":        {duration: "5m", want: 5},
+		"maximum":        {duration: "24h", want: 1440},
+		"too short":      {duration: "4m59s", wantErr: true},
+		"too long":       {duration: "24h0m1s", wantErr: true},
+		"unparsable":     {duration: "an hour", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := consoleAccessMinutes(tt.duration)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %t, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %d minutes, got %d", tt.want, got)
+			}
+		})
+	}
+}
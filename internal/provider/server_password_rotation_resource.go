@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServerPasswordRotationResource{}
+var _ resource.ResourceWithValidateConfig = &ServerPasswordRotationResource{}
+var _ resource.ResourceWithModifyPlan = &ServerPasswordRotationResource{}
+
+func NewServerPasswordRotationResource() resource.Resource {
+	return &ServerPasswordRotationResource{}
+}
+
+// ServerPasswordRotationResource defines the resource implementation.
+type ServerPasswordRotationResource struct {
+	client *ICSClient
+}
+
+// ServerPasswordRotationResourceModel describes the resource data model.
+type ServerPasswordRotationResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	ServerID          types.String `tfsdk:"server_id"`
+	Keepers           types.Map    `tfsdk:"keepers"`
+	RotateAfter       types.String `tfsdk:"rotate_after"`
+	StoreRootPassword types.Bool   `tfsdk:"store_root_password"`
+	RotatedAt         types.String `tfsdk:"rotated_at"`
+	RootPassword      types.String `tfsdk:"root_password"`
+}
+
+func (r *ServerPasswordRotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_password_rotation"
+}
+
+func (r *ServerPasswordRotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Resets the root password of a bare metal server. The password is reset again whenever `keepers` change or `rotate_after` has elapsed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Rotation identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				MarkdownDescription: "Server identifier (the `id` of an `ics_bare_metal_server`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that trigger a new rotation when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_after": schema.StringAttribute{
+				MarkdownDescription: "Rotate the password again once this Go duration (e.g., '720h') has elapsed since `rotated_at`. The rotation happens on the first plan and apply after it expires.",
+				Optional:            true,
+			},
+			"store_root_password": schema.BoolAttribute{
+				MarkdownDescription: "Whether to persist the new root password in Terraform state as `root_password`. Set to false and read the password with the `ics_server_root_password` ephemeral resource to keep it out of state. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"rotated_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp of the last rotation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"root_password": schema.StringAttribute{
+				MarkdownDescription: "Root password generated by the last rotation. Null when `store_root_password` is false.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ServerPasswordRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServerPasswordRotationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rotateAfter types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rotate_after"), &rotateAfter)...)
+
+	if rotateAfter.IsNull() || rotateAfter.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(rotateAfter.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotate_after"),
+			"Invalid Rotation Interval",
+			fmt.Sprintf("rotate_after must be a positive duration such as '720h', got: %s", rotateAfter.ValueString()),
+		)
+	}
+}
+
+func (r *ServerPasswordRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var storeRootPassword types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("store_root_password"), &storeRootPassword)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Turning storage off drops the password kept from the last rotation
+	if !storeRootPassword.IsUnknown() && !storeRootPassword.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("root_password"), types.StringNull())...)
+	}
+}
+
+func (r *ServerPasswordRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServerPasswordRotationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := data.ServerID.ValueString()
+
+	tflog.Info(ctx, "Resetting server root password", map[string]interface{}{
+		"server_id": serverID,
+	})
+
+	resetResp, err := r.client.ResetServerRootPassword(ctx, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("Root Password Reset Failed", fmt.Sprintf("Unable to reset root password for server %s: %s", serverID, err))
+		return
+	}
+
+	rotatedAt := time.Now().UTC().Format(time.RFC3339)
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", serverID, rotatedAt))
+	data.RotatedAt = types.StringValue(rotatedAt)
+	data.RootPassword = types.StringNull()
+	if data.StoreRootPassword.ValueBool() {
+		data.RootPassword = types.StringValue(resetResp.RootPassword)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerPasswordRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServerPasswordRotationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Once the rotation interval has elapsed, drop the resource so the next apply rotates again
+	if !data.RotateAfter.IsNull() {
+		rotateAfter, err := time.ParseDuration(data.RotateAfter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unable to parse rotate_after %q: %s", data.RotateAfter.ValueString(), err))
+			return
+		}
+
+		if rotationExpired(data.RotatedAt.ValueString(), rotateAfter, time.Now()) {
+			tflog.Info(ctx, "Root password rotation interval elapsed, scheduling new rotation", map[string]interface{}{
+				"server_id":  data.ServerID.ValueString(),
+				"rotated_at": data.RotatedAt.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerPasswordRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only rotate_after and store_root_password can change in place. A new
+	// interval takes effect on the next refresh, and turning storage off has
+	// already cleared root_password in the plan.
+	var data ServerPasswordRotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerPasswordRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Nothing to undo in the API: the server keeps its current root password
+	tflog.Info(ctx, "Removing root password rotation from state")
+}
+
+// rotationExpired reports whether rotateAfter has elapsed since rotatedAt. A
+// missing or unparseable rotated_at counts as expired so the password is
+// rotated again instead of refresh failing on every run.
+func rotationExpired(rotatedAt string, rotateAfter time.Duration, now time.Time) bool {
+	lastRotation, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		return true
+	}
+
+	return now.After(lastRotation.Add(rotateAfter))
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRotationExpired(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		rotatedAt   string
+		rotateAfter time.Duration
+		want        bool
+	}{
+		"within interval":   {"2024-06-01T00:00:00Z", 24 * time.Hour, false},
+		"exactly at expiry": {"2024-05-31T12:00:00Z", 24 * time.Hour, false},
+		"elapsed":           {"2024-05-01T00:00:00Z", 720 * time.Hour, true},
+		"offset timestamp":  {"2024-06-01T10:00:00-04:00", 2 * time.Hour, false},
+		"offset elapsed":    {"2024-06-01T08:00:00+01:00", 2 * time.Hour, true},
+		"unparseable":       {"yesterday", 720 * time.Hour, true},
+		"missing":           {"", 720 * time.Hour, true},
+		"not RFC 3339":      {"2024-06-01 11:00:00", 720 * time.Hour, true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := rotationExpired(tt.rotatedAt, tt.rotateAfter, now); got != tt.want {
+				t.Errorf("expected %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestServerPasswordRotationRead(t *testing.T) {
+	r := &ServerPasswordRotationResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	recent := time.Now().UTC().Add(-time.Hour).Format(time.RFC3339)
+
+	tests := map[string]struct {
+		rotateAfter tftypes.Value
+		rotatedAt   string
+		wantRemoved bool
+	}{
+		"no interval":    {tftypes.NewValue(tftypes.String, nil), "2000-01-01T00:00:00Z", false},
+		"not expired":    {tftypes.NewValue(tftypes.String, "24h"), recent, false},
+		"expired":        {tftypes.NewValue(tftypes.String, "24h"), "2000-01-01T00:00:00Z", true},
+		"bad rotated_at": {tftypes.NewValue(tftypes.String, "24h"), "not a timestamp", true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":                  tftypes.NewValue(tftypes.String, "abc123/"+tt.rotatedAt),
+					"server_id":           tftypes.NewValue(tftypes.String, "abc123"),
+					"keepers":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"rotate_after":        tt.rotateAfter,
+					"store_root_password": tftypes.NewValue(tftypes.Bool, true),
+					"rotated_at":          tftypes.NewValue(tftypes.String, tt.rotatedAt),
+					"root_password":       tftypes.NewValue(tftypes.String, "secret"),
+				}),
+			}
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if removed := resp.State.Raw.IsNull(); removed != tt.wantRemoved {
+				t.Errorf("expected removed %t, got %t", tt.wantRemoved, removed)
+			}
+		})
+	}
+}
+
+func TestServerPasswordRotationCreate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest-api/servers/abc123/reset-password" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"root_password":"n3w-s3cret"}}`))
+	}))
+	defer server.Close()
+
+	r := &ServerPasswordRotationResource{client: NewICSClient("token", server.URL)}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	tests := map[string]struct {
+		storeRootPassword bool
+		want              types.String
+	}{
+		"stored":     {true, types.StringValue("n3w-s3cret")},
+		"not stored": {false, types.StringNull()},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":                  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"server_id":           tftypes.NewValue(tftypes.String, "abc123"),
+					"keepers":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"rotate_after":        tftypes.NewValue(tftypes.String, nil),
+					"store_root_password": tftypes.NewValue(tftypes.Bool, tt.storeRootPassword),
+					"rotated_at":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"root_password":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			}
+
+			resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+			r.Create(context.Background(), resource.CreateRequest{Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var rootPassword types.String
+			resp.State.GetAttribute(context.Background(), path.Root("root_password"), &rootPassword)
+			if !rootPassword.Equal(tt.want) {
+				t.Errorf("expected root_password %s, got %s", tt.want, rootPassword)
+			}
+		})
+	}
+}
+
+func TestServerPasswordRotationModifyPlan(t *testing.T) {
+	r := &ServerPasswordRotationResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	tests := map[string]struct {
+		storeRootPassword bool
+		want              types.String
+	}{
+		"kept while stored":           {true, types.StringValue("secret")},
+		"cleared when storage is off": {false, types.StringNull()},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// root_password carries the prior value through UseStateForUnknown
+			plan := tfsdk.Plan{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":                  tftypes.NewValue(tftypes.String, "abc123/2024-06-01T00:00:00Z"),
+					"server_id":           tftypes.NewValue(tftypes.String, "abc123"),
+					"keepers":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"rotate_after":        tftypes.NewValue(tftypes.String, nil),
+					"store_root_password": tftypes.NewValue(tftypes.Bool, tt.storeRootPassword),
+					"rotated_at":          tftypes.NewValue(tftypes.String, "2024-06-01T00:00:00Z"),
+					"root_password":       tftypes.NewValue(tftypes.String, "secret"),
+				}),
+			}
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var rootPassword types.String
+			resp.Plan.GetAttribute(context.Background(), path.Root("root_password"), &rootPassword)
+			if !rootPassword.Equal(tt.want) {
+				t.Errorf("expected planned root_password %s, got %s", tt.want, rootPassword)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ServerRootPasswordEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ServerRootPasswordEphemeralResource{}
+
+func NewServerRootPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &ServerRootPasswordEphemeralResource{}
+}
+
+// ServerRootPasswordEphemeralResource defines the ephemeral resource implementation.
+type ServerRootPasswordEphemeralResource struct {
+	client *ICSClient
+}
+
+// ServerRootPasswordEphemeralResourceModel describes the ephemeral resource data model.
+type ServerRootPasswordEphemeralResourceModel struct {
+	ServerID     types.String `tfsdk:"server_id"`
+	RootPassword types.String `tfsdk:"root_password"`
+}
+
+func (e *ServerRootPasswordEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_root_password"
+}
+
+func (e *ServerRootPasswordEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fetches the root password of a bare metal server on demand without storing it in state or plan. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				MarkdownDescription: "Server identifier (the `id` of an `ics_bare_metal_server`)",
+				Required:            true,
+			},
+			"root_password": schema.StringAttribute{
+				MarkdownDescription: "Root password for the server",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *ServerRootPasswordEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+func (e *ServerRootPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ServerRootPasswordEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := data.ServerID.ValueString()
+	server, err := e.client.GetServer(ctx, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server %s, got error: %s", serverID, err))
+		return
+	}
+
+	data.RootPassword = types.StringValue(server.RootPassword)
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
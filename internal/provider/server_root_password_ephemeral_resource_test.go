@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServerRootPasswordOpen(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest-api/servers":
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":[{"id":"abc123","service_id":1001,"root_password":"s3cret"}]}`))
+		case "/rest-api/servers/abc123":
+			w.Write([]byte(`{"statusCode":200,"message":"OK","data":{"id":"abc123","service_id":1001,"root_password":"s3cret"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"Server not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	e := &ServerRootPasswordEphemeralResource{client: NewICSClient("token", server.URL)}
+
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(context.Background(), ephemeral.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	open := func(serverID string) ephemeral.OpenResponse {
+		req := ephemeral.OpenRequest{Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"server_id":     tftypes.NewValue(tftypes.String, serverID),
+				"root_password": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}}
+		resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+		e.Open(context.Background(), req, &resp)
+		return resp
+	}
+
+	resp := open("abc123")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data ServerRootPasswordEphemeralResourceModel
+	resp.Diagnostics.Append(resp.Result.Get(context.Background(), &data)...)
+	if data.RootPassword.ValueString() != "s3cret" || data.ServerID.ValueString() != "abc123" {
+		t.Errorf("unexpected result: %+v", data)
+	}
+
+	if resp := open("missing"); !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a missing server")
+	}
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServerSSHKeyAttachmentResource{}
+var _ resource.ResourceWithImportState = &ServerSSHKeyAttachmentResource{}
+
+func NewServerSSHKeyAttachmentResource() resource.Resource {
+	return &ServerSSHKeyAttachmentResource{}
+}
+
+// ServerSSHKeyAttachmentResource defines the resource implementation.
+type ServerSSHKeyAttachmentResource struct {
+	client *ICSClient
+}
+
+// ServerSSHKeyAttachmentResourceModel describes the resource data model.
+type ServerSSHKeyAttachmentResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	ServerID types.String `tfsdk:"server_id"`
+	SSHKeyID types.Int64  `tfsdk:"ssh_key_id"`
+}
+
+func (r *ServerSSHKeyAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_ssh_key_attachment"
+}
+
+func (r *ServerSSHKeyAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Assigns an existing SSH key to an existing bare metal server",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Attachment identifier in the form `<server_id>/<ssh_key_id>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				MarkdownDescription: "Server identifier (the `id` of an `ics_bare_metal_server`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh_key_id": schema.Int64Attribute{
+				MarkdownDescription: "SSH key identifier (the `id` of an `ics_ssh_key`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ServerSSHKeyAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServerSSHKeyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServerSSHKeyAttachmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := data.ServerID.ValueString()
+	keyID := int(data.SSHKeyID.ValueInt64())
+
+	tflog.Info(ctx, "Assigning SSH key to server", map[string]interface{}{
+		"server_id":  serverID,
+		"ssh_key_id": keyID,
+	})
+
+	err := r.client.AssignSSHKeyToServer(ctx, serverID, keyID)
+	if err != nil {
+		resp.Diagnostics.AddError("SSH Key Assignment Failed", fmt.Sprintf("Unable to assign SSH key %d to server %s: %s", keyID, serverID, err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%d", serverID, keyID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerSSHKeyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServerSSHKeyAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := data.ServerID.ValueString()
+	keyID := int(data.SSHKeyID.ValueInt64())
+
+	attached, err := r.isAttached(ctx, serverID, keyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SSH key %d assignments, got error: %s", keyID, err))
+		return
+	}
+
+	// The key or assignment was removed outside Terraform
+	if !attached {
+		tflog.Warn(ctx, "SSH key is no longer assigned to server, removing from state", map[string]interface{}{
+			"server_id":  serverID,
+			"ssh_key_id": keyID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerSSHKeyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so there is nothing to update in place
+	var data ServerSSHKeyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerSSHKeyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ServerSSHKeyAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := data.ServerID.ValueString()
+	keyID := int(data.SSHKeyID.ValueInt64())
+
+	err := r.client.RemoveSSHKeyFromServer(ctx, serverID, keyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove SSH key %d from server %s, got error: %s", keyID, serverID, err))
+		return
+	}
+
+	tflog.Info(ctx, "SSH key removed from server successfully", map[string]interface{}{
+		"server_id":  serverID,
+		"ssh_key_id": keyID,
+	})
+}
+
+func (r *ServerSSHKeyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by "<server_id>/<ssh_key_id>"
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected import identifier in the form <server_id>/<ssh_key_id>, got: %s", req.ID))
+		return
+	}
+
+	keyID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid SSH key ID format: %s", parts[1]))
+		return
+	}
+
+	attached, err := r.isAttached(ctx, parts[0], keyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read SSH key %d assignments: %s", keyID, err))
+		return
+	}
+
+	if !attached {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("SSH key %d is not assigned to server %s", keyID, parts[0]))
+		return
+	}
+
+	data := ServerSSHKeyAttachmentResourceModel{
+		ID:       types.StringValue(fmt.Sprintf("%s/%d", parts[0], keyID)),
+		ServerID: types.StringValue(parts[0]),
+		SSHKeyID: types.Int64Value(int64(keyID)),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// isAttached reports whether the SSH key is currently assigned to the server
+func (r *ServerSSHKeyAttachmentResource) isAttached(ctx context.Context, serverID string, keyID int) (bool, error) {
+	sshKeys, err := r.client.GetSSHKeys(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, key := range sshKeys {
+		if key.ID != keyID {
+			continue
+		}
+		for _, server := range key.AssignedServers {
+			if server.ServerID == serverID {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	return false, nil
+}
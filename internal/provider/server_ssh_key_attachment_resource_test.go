@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSSHKeyAttachmentIsAttached(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest-api/ssh-keys" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"statusCode":200,"message":"OK","data":[` +
+			`{"id":1,"label":"deploy","assigned_servers":[{"server_id":"abc123"},{"server_id":"def456"}]},` +
+			`{"id":2,"label":"oncall","assigned_servers":[]},` +
+			`{"id":3,"label":"backup","assigned_servers":null}]}`))
+	}))
+	defer server.Close()
+
+	r := &ServerSSHKeyAttachmentResource{client: NewICSClient("token", server.URL)}
+
+	tests := map[string]struct {
+		serverID string
+		keyID    int
+		want     bool
+	}{
+		"assigned":               {"abc123", 1, true},
+		"assigned to second":     {"def456", 1, true},
+		"not assigned to server": {"ghi789", 1, false},
+		"key with no servers":    {"abc123", 2, false},
+		"key with null servers":  {"abc123", 3, false},
+		"key no longer exists":   {"abc123", 4, false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := r.isAttached(context.Background(), tt.serverID, tt.keyID)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSSHKeyAttachmentIsAttachedError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"statusCode":500,"message":"Server error"}`))
+	}))
+	defer server.Close()
+
+	// An API failure must not look like a removed attachment
+	r := &ServerSSHKeyAttachmentResource{client: NewICSClient("token", server.URL)}
+	if _, err := r.isAttached(context.Background(), "abc123", 1); err == nil {
+		t.Fatal("expected an error")
+	}
+}
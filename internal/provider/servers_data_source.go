@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ServersDataSource{}
+
+func NewServersDataSource() datasource.DataSource {
+	return &ServersDataSource{}
+}
+
+// ServersDataSource defines the data source implementation.
+type ServersDataSource struct {
+	client *ICSClient
+}
+
+// ServersDataSourceModel describes the data source data model.
+type ServersDataSourceModel struct {
+	Tags    types.Map         `tfsdk:"tags"`
+	Servers []ServerDataModel `tfsdk:"servers"`
+	ID      types.String      `tfsdk:"id"`
+}
+
+type ServerDataModel struct {
+	ID              types.String `tfsdk:"id"`
+	ServiceID       types.Int64  `tfsdk:"service_id"`
+	Hostname        types.String `tfsdk:"hostname"`
+	FriendlyName    types.String `tfsdk:"friendly_name"`
+	PublicIP        types.String `tfsdk:"public_ip"`
+	InstanceType    types.String `tfsdk:"instance_type"`
+	Location        types.String `tfsdk:"location"`
+	OperatingSystem types.String `tfsdk:"operating_system"`
+	DatacenterName  types.String `tfsdk:"datacenter_name"`
+	DatacenterID    types.Int64  `tfsdk:"datacenter_id"`
+	BillHourly      types.Bool   `tfsdk:"bill_hourly"`
+	Tags            types.Map    `tfsdk:"tags"`
+}
+
+func (d *ServersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_servers"
+}
+
+func (d *ServersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Servers data source lists the bare metal servers in the account, optionally filtered by tags.",
+
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Only return servers that have all of these tags with matching values",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"servers": schema.ListNestedAttribute{
+				MarkdownDescription: "List of matching servers",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Server identifier",
+							Computed:            true,
+						},
+						"service_id": schema.Int64Attribute{
+							MarkdownDescription: "Service identifier",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "Hostname",
+							Computed:            true,
+						},
+						"friendly_name": schema.StringAttribute{
+							MarkdownDescription: "Friendly name",
+							Computed:            true,
+						},
+						"public_ip": schema.StringAttribute{
+							MarkdownDescription: "Public IP address",
+							Computed:            true,
+						},
+						"instance_type": schema.StringAttribute{
+							MarkdownDescription: "Instance type (SKU product name)",
+							Computed:            true,
+						},
+						"location": schema.StringAttribute{
+							MarkdownDescription: "Location code",
+							Computed:            true,
+						},
+						"operating_system": schema.StringAttribute{
+							MarkdownDescription: "Operating system name",
+							Computed:            true,
+						},
+						"datacenter_name": schema.StringAttribute{
+							MarkdownDescription: "Datacenter name",
+							Computed:            true,
+						},
+						"datacenter_id": schema.Int64Attribute{
+							MarkdownDescription: "Datacenter identifier",
+							Computed:            true,
+						},
+						"bill_hourly": schema.BoolAttribute{
+							MarkdownDescription: "Whether the server is billed hourly",
+							Computed:            true,
+						},
+						"tags": schema.MapAttribute{
+							MarkdownDescription: "Tags assigned to the server",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ServersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ICSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ICSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tagFilter map[string]string
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tagFilter, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Get servers from API
+	servers, err := d.client.GetServers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read servers, got error: %s", err))
+		return
+	}
+
+	// Convert API response to Terraform model
+	var results []ServerDataModel
+	for _, server := range servers {
+		if !hasTags(server.Tags, tagFilter) {
+			continue
+		}
+
+		tags, diags := types.MapValueFrom(ctx, types.StringType, server.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		results = append(results, ServerDataModel{
+			ID:              types.StringValue(server.ID),
+			ServiceID:       types.Int64Value(int64(server.ServiceID)),
+			Hostname:        types.StringValue(server.Hostname),
+			FriendlyName:    types.StringValue(server.FriendlyName),
+			PublicIP:        types.StringValue(server.PublicIP),
+			InstanceType:    types.StringValue(server.SkuProductName),
+			Location:        types.StringValue(server.LocationCode),
+			OperatingSystem: types.StringValue(server.OperatingSystem),
+			DatacenterName:  types.StringValue(server.DatacenterName),
+			DatacenterID:    types.Int64Value(int64(server.DatacenterID)),
+			BillHourly:      types.BoolValue(server.BillHourly),
+			Tags:            tags,
+		})
+	}
+
+	data.Servers = results
+	data.ID = types.StringValue("servers")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// hasTags reports whether tags contains every key of filter with the same value
+func hasTags(tags, filter map[string]string) bool {
+	for key, value := range filter {
+		if tagValue, ok := tags[key]; !ok || tagValue != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// skuRequirementKind describes how a requirement is compared against an inventory item
+type skuRequirementKind int
+
+const (
+	skuRequirementMin skuRequirementKind = iota
+	skuRequirementMax
+	skuRequirementEqualFold
+	skuRequirementBool
+	skuRequirementAvailable
+)
+
+// skuRequirement maps a requirement key to the inventory item attribute it checks
+type skuRequirement struct {
+	attribute string
+	kind      skuRequirementKind
+}
+
+// skuRequirements are the requirement keys supported by sku_matches
+var skuRequirements = map[string]skuRequirement{
+	"available":               {"auto_provision_quantity", skuRequirementAvailable},
+	"cpu_brand":               {"cpu_brand", skuRequirementEqualFold},
+	"hourly_enabled":          {"hourly_enabled", skuRequirementBool},
+	"location_code":           {"location_code", skuRequirementEqualFold},
+	"max_price":               {"price_amount", skuRequirementMax},
+	"max_price_hourly":        {"price_hourly_amount", skuRequirementMax},
+	"min_cpu_clock_speed_ghz": {"cpu_clock_speed_ghz", skuRequirementMin},
+	"min_cpu_cores":           {"cpu_cores", skuRequirementMin},
+	"min_cpu_count":           {"cpu_count", skuRequirementMin},
+	"min_hdd_gb":              {"total_hdd_size_gb", skuRequirementMin},
+	"min_nic_speed_mbps":      {"nic_speed_mbps", skuRequirementMin},
+	"min_nvme_gb":             {"total_nvme_size_gb", skuRequirementMin},
+	"min_ram_gb":              {"total_ram_gb", skuRequirementMin},
+	"min_ssd_gb":              {"total_ssd_size_gb", skuRequirementMin},
+	"raid_enabled":            {"raid_enabled", skuRequirementBool},
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SKUMatchesFunction{}
+
+func NewSKUMatchesFunction() function.Function {
+	return &SKUMatchesFunction{}
+}
+
+// SKUMatchesFunction defines the function implementation.
+type SKUMatchesFunction struct{}
+
+func (f *SKUMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sku_matches"
+}
+
+func (f *SKUMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether an inventory item meets a set of requirements",
+		MarkdownDescription: "Returns true if an `ics_inventory` item meets every requirement. Supported requirements are " +
+			"`min_cpu_cores`, `min_cpu_count`, `min_cpu_clock_speed_ghz`, `min_ram_gb`, `min_ssd_gb`, `min_hdd_gb`, `min_nvme_gb`, " +
+			"`min_nic_speed_mbps`, `max_price`, `max_price_hourly`, `cpu_brand`, `location_code`, `raid_enabled`, `hourly_enabled` " +
+			"and `available` (true to require auto-provisionable inventory). Null requirements are ignored.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "item",
+				MarkdownDescription: "An element of the `items` attribute of the `ics_inventory` data source",
+			},
+			function.DynamicParameter{
+				Name:                "requirements",
+				MarkdownDescription: "Object or map of requirements, e.g. `{ min_ram_gb = 64, cpu_brand = \"AMD\" }`",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *SKUMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var item, requirements types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &item, &requirements))
+	if resp.Error != nil {
+		return
+	}
+
+	itemAttributes, ok := objectAttributes(item)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, "item must be an object, such as an element of data.ics_inventory.<name>.items")
+		return
+	}
+
+	requirementValues, ok := objectAttributes(requirements)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(1, "requirements must be an object or map")
+		return
+	}
+
+	result, funcErr := skuMatches(itemAttributes, requirementValues)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// skuMatches checks every non-null requirement against the item. The result is
+// unknown if any value it depends on is unknown.
+func skuMatches(item, requirements map[string]attr.Value) (types.Bool, *function.FuncError) {
+	// Check requirements in a stable order so errors are deterministic
+	keys := make([]string, 0, len(requirements))
+	for key := range requirements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	matches := true
+	for _, key := range keys {
+		wanted := underlyingValue(requirements[key])
+		if wanted.IsNull() {
+			continue
+		}
+
+		requirement, ok := skuRequirements[key]
+		if !ok {
+			supported := make([]string, 0, len(skuRequirements))
+			for name := range skuRequirements {
+				supported = append(supported, name)
+			}
+			sort.Strings(supported)
+			return types.BoolNull(), function.NewArgumentFuncError(1, fmt.Sprintf("unsupported requirement %q; supported requirements are: %s", key, strings.Join(supported, ", ")))
+		}
+
+		value, ok := item[requirement.attribute]
+		if !ok {
+			return types.BoolNull(), function.NewArgumentFuncError(0, fmt.Sprintf("item has no %q attribute, which requirement %q needs", requirement.attribute, key))
+		}
+		value = underlyingValue(value)
+
+		if wanted.IsUnknown() || value.IsUnknown() {
+			return types.BoolUnknown(), nil
+		}
+		if value.IsNull() {
+			matches = false
+			continue
+		}
+
+		ok, err := requirementMet(requirement.kind, value, wanted)
+		if err != nil {
+			return types.BoolNull(), function.NewFuncError(fmt.Sprintf("requirement %q: %s", key, err))
+		}
+		if !ok {
+			matches = false
+		}
+	}
+
+	return types.BoolValue(matches), nil
+}
+
+// requirementMet compares a single item value against the wanted value
+func requirementMet(kind skuRequirementKind, value, wanted attr.Value) (bool, error) {
+	switch kind {
+	case skuRequirementMin, skuRequirementMax:
+		have, err := numberFromValue(value)
+		if err != nil {
+			return false, err
+		}
+		want, err := numberFromValue(wanted)
+		if err != nil {
+			return false, err
+		}
+		if kind == skuRequirementMin {
+			return have >= want, nil
+		}
+		return have <= want, nil
+	case skuRequirementEqualFold:
+		have, ok := value.(basetypes.StringValue)
+		want, wantOK := wanted.(basetypes.StringValue)
+		if !ok || !wantOK {
+			return false, fmt.Errorf("expected a string")
+		}
+		return strings.EqualFold(have.ValueString(), want.ValueString()), nil
+	case skuRequirementBool:
+		have, ok := value.(basetypes.BoolValue)
+		want, wantOK := wanted.(basetypes.BoolValue)
+		if !ok || !wantOK {
+			return false, fmt.Errorf("expected a bool")
+		}
+		return have.ValueBool() == want.ValueBool(), nil
+	case skuRequirementAvailable:
+		quantity, err := numberFromValue(value)
+		if err != nil {
+			return false, err
+		}
+		want, ok := wanted.(basetypes.BoolValue)
+		if !ok {
+			return false, fmt.Errorf("expected a bool")
+		}
+		return (quantity > 0) == want.ValueBool(), nil
+	}
+
+	return false, fmt.Errorf("unsupported comparison")
+}
+
+// numberFromValue converts numeric values, and price strings, to a float64
+func numberFromValue(value attr.Value) (float64, error) {
+	switch v := value.(type) {
+	case basetypes.NumberValue:
+		f, _ := v.ValueBigFloat().Float64()
+		return f, nil
+	case basetypes.Int64Value:
+		return float64(v.ValueInt64()), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.StringValue:
+		price, err := parsePrice(v.ValueString())
+		if err != nil {
+			return 0, err
+		}
+		f, _ := price.Float64()
+		return f, nil
+	}
+
+	return 0, fmt.Errorf("expected a number, got %s", value.Type(context.Background()))
+}
+
+// objectAttributes returns the attributes of an object or the elements of a map
+func objectAttributes(value attr.Value) (map[string]attr.Value, bool) {
+	switch v := underlyingValue(value).(type) {
+	case basetypes.ObjectValue:
+		return v.Attributes(), true
+	case basetypes.MapValue:
+		return v.Elements(), true
+	}
+
+	return nil, false
+}
+
+// underlyingValue unwraps dynamic values
+func underlyingValue(value attr.Value) attr.Value {
+	if dynamic, ok := value.(basetypes.DynamicValue); ok {
+		if dynamic.IsNull() || dynamic.IsUnknown() {
+			return dynamic
+		}
+		return underlyingValue(dynamic.UnderlyingValue())
+	}
+
+	return value
+}
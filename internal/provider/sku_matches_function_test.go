@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testInventoryItem() types.Object {
+	return types.ObjectValueMust(
+		map[string]attr.Type{
+			"cpu_brand":               types.StringType,
+			"cpu_cores":               types.Int64Type,
+			"total_ram_gb":            types.Int64Type,
+			"price_amount":            types.NumberType,
+			"raid_enabled":            types.BoolType,
+			"auto_provision_quantity": types.Int64Type,
+		},
+		map[string]attr.Value{
+			"cpu_brand":               types.StringValue("AMD"),
+			"cpu_cores":               types.Int64Value(16),
+			"total_ram_gb":            types.Int64Value(128),
+			"price_amount":            types.NumberValue(big.NewFloat(249)),
+			"raid_enabled":            types.BoolValue(true),
+			"auto_provision_quantity": types.Int64Value(3),
+		},
+	)
+}
+
+func runSKUMatches(t *testing.T, requirements attr.Value) function.RunResponse {
+	t.Helper()
+
+	resp := function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+	NewSKUMatchesFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.DynamicValue(testInventoryItem()),
+			types.DynamicValue(requirements),
+		}),
+	}, &resp)
+
+	return resp
+}
+
+func TestSKUMatchesFunction(t *testing.T) {
+	tests := []struct {
+		name         string
+		requirements map[string]attr.Value
+		want         bool
+	}{
+		{
+			name: "all requirements met",
+			requirements: map[string]attr.Value{
+				"min_ram_gb":   types.Int64Value(64),
+				"cpu_brand":    types.StringValue("amd"),
+				"max_price":    types.NumberValue(big.NewFloat(250)),
+				"raid_enabled": types.BoolValue(true),
+				"available":    types.BoolValue(true),
+			},
+			want: true,
+		},
+		{
+			name:         "not enough cores",
+			requirements: map[string]attr.Value{"min_cpu_cores": types.Int64Value(32)},
+			want:         false,
+		},
+		{
+			name:         "too expensive",
+			requirements: map[string]attr.Value{"max_price": types.StringValue("199.99")},
+			want:         false,
+		},
+		{
+			name:         "null requirement ignored",
+			requirements: map[string]attr.Value{"min_cpu_cores": types.Int64Null()},
+			want:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrTypes := make(map[string]attr.Type, len(tt.requirements))
+			for key, value := range tt.requirements {
+				attrTypes[key] = value.Type(context.Background())
+			}
+
+			resp := runSKUMatches(t, types.ObjectValueMust(attrTypes, tt.requirements))
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if got := resp.Result.Value(); !got.Equal(types.BoolValue(tt.want)) {
+				t.Errorf("sku_matches = %s, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSKUMatchesFunctionUnsupportedRequirement(t *testing.T) {
+	resp := runSKUMatches(t, types.MapValueMust(types.Int64Type, map[string]attr.Value{
+		"min_gpu_count": types.Int64Value(1),
+	}))
+	if resp.Error == nil {
+		t.Fatal("expected an error for an unsupported requirement")
+	}
+	if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 1 {
+		t.Errorf("expected the error to refer to the requirements argument, got: %v", resp.Error.FunctionArgument)
+	}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SSHFingerprintFunction{}
+
+func NewSSHFingerprintFunction() function.Function {
+	return &SSHFingerprintFunction{}
+}
+
+// SSHFingerprintFunction defines the function implementation.
+type SSHFingerprintFunction struct{}
+
+func (f *SSHFingerprintFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ssh_fingerprint"
+}
+
+func (f *SSHFingerprintFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Compute the SHA256 fingerprint of an SSH public key",
+		MarkdownDescription: "Returns the SHA256 fingerprint of an OpenSSH public key in the same format as `ssh-keygen -l`, e.g. `SHA256:tZOc/fzwLYyA8xVq6dl3NDcdc27vxHudDuTHqpMmlRw`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "public_key",
+				MarkdownDescription: "Public key in OpenSSH authorized_keys format, e.g. `ssh-ed25519 AAAA... user@host`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SSHFingerprintFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var publicKey string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &publicKey))
+	if resp.Error != nil {
+		return
+	}
+
+	fingerprint, err := sshFingerprint(publicKey)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, fingerprint))
+}
+
+// sshFingerprint returns the SHA256 fingerprint of an authorized_keys formatted public key
+func sshFingerprint(publicKey string) (string, error) {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return "", fmt.Errorf("public key must be in the form \"<type> <base64 key> [comment]\"")
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", fmt.Errorf("public key data is not valid base64: %w", err)
+	}
+
+	// The key blob starts with its own length-prefixed key type, which must match the declared type
+	if len(blob) < 4 {
+		return "", fmt.Errorf("public key data is too short")
+	}
+	typeLen := binary.BigEndian.Uint32(blob[:4])
+	if uint64(len(blob)-4) < uint64(typeLen) || string(blob[4:4+typeLen]) != fields[0] {
+		return "", fmt.Errorf("public key data does not match key type %q", fields[0])
+	}
+
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
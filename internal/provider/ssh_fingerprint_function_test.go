@@ -0,0 +1,61 @@
+package provider
+
+import "testing"
+
+func TestSSHFingerprint(t *testing.T) {
+	tests := []struct {
+		name      string
+		publicKey string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "ed25519",
+			publicKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIATeSylbRFpi9jkO+CRQE+YYc0MT3H+eTd6seEJU4CH+ test@example",
+			want:      "SHA256:tZOc/fzwLYyA8xVq6dl3NDcdc27vxHudDuTHqpMmlRw",
+		},
+		{
+			name:      "rsa",
+			publicKey: "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDQjOXABGUvCaZEouzwXEs8RuyE8GhcKKrS+0JgmlJ7eM2WMWfAZgR6VOONdDg0/6g8y1ffZZoCGFo5LbWyElt1XBAaWEdQSS9O81DxU5utdIBwHHvad5W3BL0VnybRkWEatNFpNqFxPI53OUJWFav17yh/cj3KtJyG8PHt3HMznLyGb07tR9P3qTtkAfJRJ6OKEVm1Ca/WZJqZJ5+1wqKLWF7GRSYIozRtE9LJ7b4FqDtD6L4sHlXWXjaGtWrzSy71+6sw1uf6+vJDkPtrl8/6nZLW12u6gilYis2Lce/UhIE6+09EuxdruX46eyv/e4KqqHQG09c3Gvz6s3Sn18rP rsa@example",
+			want:      "SHA256:QIKhuX//jNA15C72eV+Sf94+GjTlpKNjD8wL6iJHJxw",
+		},
+		{
+			name:      "without comment",
+			publicKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIATeSylbRFpi9jkO+CRQE+YYc0MT3H+eTd6seEJU4CH+",
+			want:      "SHA256:tZOc/fzwLYyA8xVq6dl3NDcdc27vxHudDuTHqpMmlRw",
+		},
+		{
+			name:      "type mismatch",
+			publicKey: "ssh-rsa AAAAC3NzaC1lZDI1NTE5AAAAIATeSylbRFpi9jkO+CRQE+YYc0MT3H+eTd6seEJU4CH+",
+			wantErr:   true,
+		},
+		{
+			name:      "invalid base64",
+			publicKey: "ssh-ed25519 not-base64!",
+			wantErr:   true,
+		},
+		{
+			name:      "missing key data",
+			publicKey: "ssh-ed25519",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sshFingerprint(tt.publicKey)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("sshFingerprint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
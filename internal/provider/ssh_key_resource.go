@@ -122,14 +122,14 @@ func (r *SSHKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	})
 
 	// Create the SSH key
-	_, err := r.client.CreateSSHKey(createReq)
+	_, err := r.client.CreateSSHKey(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError("SSH Key Creation Failed", fmt.Sprintf("Unable to create SSH key: %s", err))
 		return
 	}
 
 	// Get the full SSH key details to populate computed fields
-	sshKey, err := r.client.GetSSHKeyByLabel(label)
+	sshKey, err := r.client.GetSSHKeyByLabel(ctx, label)
 	if err != nil {
 		resp.Diagnostics.AddError("SSH Key Retrieval Failed", fmt.Sprintf("SSH key created but unable to retrieve details: %s", err))
 		return
@@ -159,7 +159,7 @@ func (r *SSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	// Get current state from API using the label (since that's what users work with)
 	label := data.Label.ValueString()
-	sshKey, err := r.client.GetSSHKeyByLabel(label)
+	sshKey, err := r.client.GetSSHKeyByLabel(ctx, label)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SSH key with label '%s', got error: %s", label, err))
 		return
@@ -200,7 +200,7 @@ func (r *SSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	keyID := int(data.ID.ValueInt64())
-	err := r.client.DeleteSSHKey(keyID)
+	err := r.client.DeleteSSHKey(ctx, keyID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SSH key %d, got error: %s", keyID, err))
 		return
@@ -215,7 +215,7 @@ func (r *SSHKeyResource) ImportState(ctx context.Context, req resource.ImportSta
 	// Import by label (more user-friendly than ID)
 	label := req.ID
 
-	sshKey, err := r.client.GetSSHKeyByLabel(label)
+	sshKey, err := r.client.GetSSHKeyByLabel(ctx, label)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to find SSH key with label '%s': %s", label, err))
 		return
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// transportSettings holds the provider arguments that configure the HTTP transport
+type transportSettings struct {
+	ProxyURL           string
+	CACertFile         string
+	CACertPEM          string
+	InsecureSkipVerify bool
+	ClientCertFile     string
+	ClientKeyFile      string
+	ClientCertPEM      string
+	ClientKeyPEM       string
+}
+
+// newHTTPTransport builds the transport used by the API client. Without a
+// proxy_url the standard HTTPS_PROXY/HTTP_PROXY/NO_PROXY variables apply.
+func newHTTPTransport(settings transportSettings) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if settings.ProxyURL != "" {
+		proxyURL, err := url.Parse(settings.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("proxy_url %q is not a valid URL", settings.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: settings.InsecureSkipVerify,
+	}
+
+	if settings.CACertFile != "" || settings.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if settings.CACertFile != "" {
+			pem, err := readPEMFile(settings.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read ca_cert_file: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("ca_cert_file %s contains no PEM encoded certificates", settings.CACertFile)
+			}
+		}
+
+		if settings.CACertPEM != "" && !pool.AppendCertsFromPEM([]byte(settings.CACertPEM)) {
+			return nil, fmt.Errorf("ca_cert_pem contains no PEM encoded certificates")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	certPEM, keyPEM := []byte(settings.ClientCertPEM), []byte(settings.ClientKeyPEM)
+	if settings.ClientCertFile != "" {
+		pem, err := readPEMFile(settings.ClientCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client_cert_file: %w", err)
+		}
+		certPEM = pem
+	}
+	if settings.ClientKeyFile != "" {
+		pem, err := readPEMFile(settings.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client_key_file: %w", err)
+		}
+		keyPEM = pem
+	}
+
+	if len(certPEM) > 0 || len(keyPEM) > 0 {
+		if len(certPEM) == 0 || len(keyPEM) == 0 {
+			return nil, fmt.Errorf("client certificate authentication needs both a certificate and a private key")
+		}
+
+		certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// readPEMFile reads a certificate or key file, expanding a leading ~
+func readPEMFile(file string) ([]byte, error) {
+	path, err := expandHome(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(path)
+}
+
+// userAgent identifies the provider and Terraform versions to the API
+func userAgent(providerVersion, terraformVersion string) string {
+	if terraformVersion == "" {
+		terraformVersion = "unknown"
+	}
+
+	return fmt.Sprintf("terraform-provider-ics/%s Terraform/%s (+https://registry.terraform.io/providers/UK2Group/ingenuitycloudservices)", providerVersion, terraformVersion)
+}
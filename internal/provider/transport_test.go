@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testAPIResponse = `{"statusCode":200,"message":"OK","data":[]}`
+
+// testClient returns a client for the server using the given transport settings
+func testClient(t *testing.T, serverURL string, settings transportSettings) *ICSClient {
+	t.Helper()
+
+	transport, err := newHTTPTransport(settings)
+	if err != nil {
+		t.Fatalf("unexpected transport error: %s", err)
+	}
+
+	client := NewICSClient("token", serverURL)
+	client.HTTPClient.Transport = transport
+	client.UserAgent = userAgent("1.2.3", "1.9.0")
+
+	return client
+}
+
+// serverCAPEM returns the certificate of a TLS test server in PEM form
+func serverCAPEM(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+// testClientCertificate generates a self-signed client certificate and key in PEM form
+func testClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %s", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return string(certPEM), string(keyPEM)
+}
+
+func TestTransportCACertificate(t *testing.T) {
+	var gotUserAgent string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.Header.Get("User-Agent")
+		w.Write([]byte(testAPIResponse))
+	}))
+	defer server.Close()
+
+	// The test server's certificate is not trusted by default
+	if _, err := testClient(t, server.URL, transportSettings{}).GetLocations(context.Background()); err == nil {
+		t.Fatal("expected an error for an untrusted certificate")
+	}
+
+	if _, err := testClient(t, server.URL, transportSettings{CACertPEM: serverCAPEM(server)}).GetLocations(context.Background()); err != nil {
+		t.Fatalf("unexpected error with ca_cert_pem: %s", err)
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(serverCAPEM(server)), 0600); err != nil {
+		t.Fatalf("failed to write CA file: %s", err)
+	}
+	if _, err := testClient(t, server.URL, transportSettings{CACertFile: caFile}).GetLocations(context.Background()); err != nil {
+		t.Fatalf("unexpected error with ca_cert_file: %s", err)
+	}
+
+	if !strings.HasPrefix(gotUserAgent, "terraform-provider-ics/1.2.3 Terraform/1.9.0") {
+		t.Errorf("unexpected User-Agent: %q", gotUserAgent)
+	}
+}
+
+func TestTransportInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testAPIResponse))
+	}))
+	defer server.Close()
+
+	if _, err := testClient(t, server.URL, transportSettings{InsecureSkipVerify: true}).GetLocations(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestTransportClientCertificate(t *testing.T) {
+	certPEM, keyPEM := testClientCertificate(t)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM([]byte(certPEM))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testAPIResponse))
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	caPEM := serverCAPEM(server)
+
+	if _, err := testClient(t, server.URL, transportSettings{CACertPEM: caPEM}).GetLocations(context.Background()); err == nil {
+		t.Fatal("expected an error without a client certificate")
+	}
+
+	settings := transportSettings{CACertPEM: caPEM, ClientCertPEM: certPEM, ClientKeyPEM: keyPEM}
+	if _, err := testClient(t, server.URL, settings).GetLocations(context.Background()); err != nil {
+		t.Fatalf("unexpected error with a client certificate: %s", err)
+	}
+}
+
+func TestTransportProxy(t *testing.T) {
+	var proxiedURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURL = r.URL.String()
+		w.Write([]byte(testAPIResponse))
+	}))
+	defer proxy.Close()
+
+	client := testClient(t, "http://api.ics.invalid", transportSettings{ProxyURL: proxy.URL})
+	if _, err := client.GetLocations(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if proxiedURL != "http://api.ics.invalid/rest-api/locations" {
+		t.Errorf("expected the request to go through the proxy, got %q", proxiedURL)
+	}
+}
+
+func TestTransportInvalidSettings(t *testing.T) {
+	certPEM, _ := testClientCertificate(t)
+
+	tests := map[string]transportSettings{
+		"invalid proxy":       {ProxyURL: "proxy.example.com"},
+		"invalid CA":          {CACertPEM: "not a certificate"},
+		"missing CA file":     {CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"certificate only":    {ClientCertPEM: certPEM},
+		"mismatched key":      {ClientCertPEM: certPEM, ClientKeyPEM: "not a key"},
+		"missing client cert": {ClientCertFile: filepath.Join(t.TempDir(), "missing.pem"), ClientKeyPEM: "key"},
+	}
+
+	for name, settings := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := newHTTPTransport(settings); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}